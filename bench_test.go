@@ -101,6 +101,25 @@ func BenchmarkFormatBE(b *testing.B) {
 	}
 }
 
+// BenchmarkFormatBEDateOnly benchmarks the "2006-01-02" fast path for BE
+func BenchmarkFormatBEDateOnly(b *testing.B) {
+	b.ReportAllocs()
+	beTime := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE())
+	for b.Loop() {
+		_ = beTime.Format("2006-01-02")
+	}
+}
+
+// BenchmarkFormatBEDateOnlyGeneral benchmarks the general format-then-replace
+// layout-based path for "2006-01-02", for comparison with BenchmarkFormatBEDateOnly
+func BenchmarkFormatBEDateOnlyGeneral(b *testing.B) {
+	b.ReportAllocs()
+	beTime := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE())
+	for b.Loop() {
+		_ = formatWithEraYear(beTime.Time, "2006-01-02", beTime.Year())
+	}
+}
+
 func BenchmarkString(b *testing.B) {
 	b.ReportAllocs()
 	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
@@ -312,25 +331,21 @@ func BenchmarkYearBECacheHit(b *testing.B) {
 	}
 }
 
-// BenchmarkReplaceYearInFormatted benchmarks the year replacement hot path
-func BenchmarkReplaceYearInFormatted(b *testing.B) {
+// BenchmarkFormatWithEraYear benchmarks the era year formatting hot path
+func BenchmarkFormatWithEraYear(b *testing.B) {
 	b.ReportAllocs()
-	formatted := "29 February 2024 12:30:45"
-	// Use a fixed reference date for consistent benchmarks
-	SetYearFormatReferenceDate(stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC))
+	tm := stdtime.Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
 	for b.Loop() {
-		_ = replaceYearInFormatted(formatted, 2567)
+		_ = formatWithEraYear(tm, "02 January 2006 15:04:05", 2567)
 	}
 }
 
-// BenchmarkReplaceYearInFormattedShortYear benchmarks short year replacement
-func BenchmarkReplaceYearInFormattedShortYear(b *testing.B) {
+// BenchmarkFormatWithEraYearShortYear benchmarks short year formatting
+func BenchmarkFormatWithEraYearShortYear(b *testing.B) {
 	b.ReportAllocs()
-	formatted := "29/02/24 12:30:45"
-	// Use a fixed reference date for consistent benchmarks
-	SetYearFormatReferenceDate(stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC))
+	tm := stdtime.Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
 	for b.Loop() {
-		_ = replaceYearInFormatted(formatted, 67)
+		_ = formatWithEraYear(tm, "02/01/06 15:04:05", 2567)
 	}
 }
 
@@ -366,6 +381,36 @@ func BenchmarkEraCacheSet(b *testing.B) {
 	}
 }
 
+// BenchmarkRegexPoolReplaceAllStringFunc benchmarks RegexPool.ReplaceAllStringFunc() performance
+func BenchmarkRegexPoolReplaceAllStringFunc(b *testing.B) {
+	b.ReportAllocs()
+	rp := internal.NewRegexPool(`\b(\d{4,10})\b`)
+	input := "15 January 2567 at 10:30"
+	for b.Loop() {
+		_ = rp.ReplaceAllStringFunc(input, func(match string) string { return match })
+	}
+}
+
+// BenchmarkConcurrentRegexPool benchmarks RegexPool under concurrent access
+func BenchmarkConcurrentRegexPool(b *testing.B) {
+	b.ReportAllocs()
+	rp := internal.NewRegexPool(`\b(\d{4,10})\b`)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = rp.MatchString("15 January 2567")
+		}
+	})
+}
+
+// BenchmarkParseWithEraBE benchmarks BE parsing, which locates the year with a regex
+func BenchmarkParseWithEraBE(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = ParseWithEra("2006-01-02", "2567-01-15", BE())
+	}
+}
+
 // BenchmarkStringReplacerReplace benchmarks StringReplacer.Replace() performance
 func BenchmarkStringReplacerReplace(b *testing.B) {
 	b.ReportAllocs()
@@ -0,0 +1,129 @@
+// Package time provides business-day arithmetic that skips weekends and
+// public holidays, such as Thai government holidays.
+package time
+
+import (
+	"sync"
+	stdtime "time"
+)
+
+// BusinessCalendar defines working days as the days that are neither a
+// weekend day nor a registered holiday. Holidays are matched by calendar
+// date (year, month, day) in the calendar's location.
+//
+// A BusinessCalendar is safe for concurrent use.
+type BusinessCalendar struct {
+	loc     *stdtime.Location
+	weekend [7]bool
+
+	mu       sync.RWMutex
+	holidays map[calendarDate]struct{}
+}
+
+// calendarDate identifies a day independently of clock time and era.
+type calendarDate struct {
+	year  int
+	month stdtime.Month
+	day   int
+}
+
+// NewBusinessCalendar creates a BusinessCalendar that evaluates dates in loc.
+// If loc is nil, time.Local is used. The weekend days default to Saturday
+// and Sunday when none are given. Weekdays outside Sunday through Saturday
+// are ignored.
+//
+// Example:
+//
+//	bangkok, _ := stdtime.LoadLocation("Asia/Bangkok")
+//	cal := NewBusinessCalendar(bangkok)
+//	cal.AddHolidays(Date(2024, 4, 15, 0, 0, 0, 0, bangkok)) // Songkran
+//	due := cal.AddBusinessDays(Now(), 5)
+func NewBusinessCalendar(loc *stdtime.Location, weekend ...stdtime.Weekday) *BusinessCalendar {
+	if loc == nil {
+		loc = stdtime.Local
+	}
+	if len(weekend) == 0 {
+		weekend = []stdtime.Weekday{stdtime.Saturday, stdtime.Sunday}
+	}
+
+	c := &BusinessCalendar{
+		loc:      loc,
+		holidays: make(map[calendarDate]struct{}),
+	}
+	for _, day := range weekend {
+		if day >= stdtime.Sunday && day <= stdtime.Saturday {
+			c.weekend[day] = true
+		}
+	}
+	return c
+}
+
+// dateOf returns the calendar date of t in the calendar's location.
+func (c *BusinessCalendar) dateOf(t Time) calendarDate {
+	y, m, d := t.Time.In(c.loc).Date()
+	return calendarDate{year: y, month: m, day: d}
+}
+
+// AddHolidays registers the calendar dates of the given times, in the
+// calendar's location, as holidays. Clock time and era are ignored.
+func (c *BusinessCalendar) AddHolidays(dates ...Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, date := range dates {
+		c.holidays[c.dateOf(date)] = struct{}{}
+	}
+}
+
+// IsHoliday reports whether t falls on a registered holiday.
+func (c *BusinessCalendar) IsHoliday(t Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.holidays[c.dateOf(t)]
+	return ok
+}
+
+// IsWeekend reports whether t falls on one of the calendar's weekend days.
+func (c *BusinessCalendar) IsWeekend(t Time) bool {
+	return c.weekend[t.Time.In(c.loc).Weekday()]
+}
+
+// IsBusinessDay reports whether t falls on a working day, that is, neither
+// a weekend day nor a holiday.
+func (c *BusinessCalendar) IsBusinessDay(t Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// AddBusinessDays returns t moved forward by n business days, or backward
+// if n is negative, keeping t's era and clock time. Weekend days and
+// holidays are skipped. If n is 0, t is returned unchanged even when it is
+// not a business day.
+//
+// If the calendar has no working days at all (every weekday is a weekend
+// day), t is returned unchanged.
+func (c *BusinessCalendar) AddBusinessDays(t Time, n int) Time {
+	step := 1
+	if n < 0 {
+		step = -1
+	}
+
+	hasWorkingDays := false
+	for _, isWeekend := range c.weekend {
+		if !isWeekend {
+			hasWorkingDays = true
+			break
+		}
+	}
+	if !hasWorkingDays {
+		return t
+	}
+
+	for n != 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsBusinessDay(t) {
+			n -= step
+		}
+	}
+	return t
+}
@@ -0,0 +1,120 @@
+package time
+
+import (
+	"testing"
+	stdtime "time"
+)
+
+// newThaiBusinessCalendar returns a calendar with the April 2024 Thai
+// public holidays: Chakri Day (observed Monday 8 April) and Songkran
+// (13-16 April, including the substitution day).
+func newThaiBusinessCalendar(loc *stdtime.Location) *BusinessCalendar {
+	cal := NewBusinessCalendar(loc)
+	cal.AddHolidays(
+		Date(2024, 4, 6, 0, 0, 0, 0, loc),
+		Date(2024, 4, 8, 0, 0, 0, 0, loc),
+		Date(2024, 4, 13, 0, 0, 0, 0, loc),
+		Date(2024, 4, 14, 0, 0, 0, 0, loc),
+		Date(2024, 4, 15, 0, 0, 0, 0, loc),
+		Date(2024, 4, 16, 0, 0, 0, 0, loc),
+	)
+	return cal
+}
+
+// TestBusinessCalendarIsBusinessDay tests weekend and holiday detection
+func TestBusinessCalendarIsBusinessDay(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	cal := newThaiBusinessCalendar(bangkok)
+
+	tests := []struct {
+		name     string
+		tm       Time
+		expected bool
+	}{
+		{"Friday", Date(2024, 4, 12, 9, 0, 0, 0, bangkok), true},
+		{"Saturday", Date(2024, 4, 20, 9, 0, 0, 0, bangkok), false},
+		{"Songkran Monday", Date(2024, 4, 15, 9, 0, 0, 0, bangkok), false},
+		{"Holiday in BE", Date(2024, 4, 16, 9, 0, 0, 0, bangkok).InEra(BE()), false},
+		// 20:00 UTC on 15 April is 03:00 on 16 April in Bangkok
+		{"Holiday matched in calendar location", Date(2024, 4, 15, 20, 0, 0, 0, stdtime.UTC), false},
+		// 18:00 UTC on 16 April is 01:00 on 17 April in Bangkok
+		{"Working day in calendar location", Date(2024, 4, 16, 18, 0, 0, 0, stdtime.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.IsBusinessDay(tt.tm); got != tt.expected {
+				t.Errorf("IsBusinessDay(%v) = %v, want %v", tt.tm.Time, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestBusinessCalendarAddBusinessDays tests skipping weekends and holidays
+func TestBusinessCalendarAddBusinessDays(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	cal := newThaiBusinessCalendar(bangkok)
+
+	tests := []struct {
+		name     string
+		start    Time
+		n        int
+		expected stdtime.Time
+	}{
+		{"Friday + 1 across weekend and Chakri Day", Date(2024, 4, 5, 10, 0, 0, 0, bangkok), 1, stdtime.Date(2024, 4, 9, 10, 0, 0, 0, bangkok)},
+		{"Friday + 1 across weekend and Songkran", Date(2024, 4, 12, 10, 0, 0, 0, bangkok), 1, stdtime.Date(2024, 4, 17, 10, 0, 0, 0, bangkok)},
+		{"Thursday + 3", Date(2024, 4, 11, 10, 0, 0, 0, bangkok), 3, stdtime.Date(2024, 4, 18, 10, 0, 0, 0, bangkok)},
+		{"Wednesday - 1 back across Songkran", Date(2024, 4, 17, 10, 0, 0, 0, bangkok), -1, stdtime.Date(2024, 4, 12, 10, 0, 0, 0, bangkok)},
+		{"Saturday + 0 unchanged", Date(2024, 4, 13, 10, 0, 0, 0, bangkok), 0, stdtime.Date(2024, 4, 13, 10, 0, 0, 0, bangkok)},
+		{"Saturday + 1", Date(2024, 4, 20, 10, 0, 0, 0, bangkok), 1, stdtime.Date(2024, 4, 22, 10, 0, 0, 0, bangkok)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cal.AddBusinessDays(tt.start.InEra(BE()), tt.n)
+			if !got.Time.Equal(tt.expected) {
+				t.Errorf("AddBusinessDays(%v, %d) = %v, want %v", tt.start.Time, tt.n, got.Time, tt.expected)
+			}
+			if got.Era() != BE() {
+				t.Errorf("AddBusinessDays().Era() = %v, want BE", got.Era())
+			}
+		})
+	}
+}
+
+// TestBusinessCalendarCustomWeekend tests calendars with non-default weekends
+func TestBusinessCalendarCustomWeekend(t *testing.T) {
+	cal := NewBusinessCalendar(stdtime.UTC, stdtime.Friday, stdtime.Saturday)
+
+	// Thursday + 1 skips Friday and Saturday
+	got := cal.AddBusinessDays(Date(2024, 4, 11, 0, 0, 0, 0, stdtime.UTC), 1)
+	if expected := stdtime.Date(2024, 4, 14, 0, 0, 0, 0, stdtime.UTC); !got.Time.Equal(expected) {
+		t.Errorf("AddBusinessDays() = %v, want %v", got.Time, expected)
+	}
+	if !cal.IsBusinessDay(Date(2024, 4, 14, 0, 0, 0, 0, stdtime.UTC)) {
+		t.Error("IsBusinessDay(Sunday) = false, want true")
+	}
+
+	allWeekend := NewBusinessCalendar(stdtime.UTC,
+		stdtime.Sunday, stdtime.Monday, stdtime.Tuesday, stdtime.Wednesday,
+		stdtime.Thursday, stdtime.Friday, stdtime.Saturday)
+	start := Date(2024, 4, 11, 0, 0, 0, 0, stdtime.UTC)
+	if got := allWeekend.AddBusinessDays(start, 5); !got.Time.Equal(start.Time) {
+		t.Errorf("AddBusinessDays() with no working days = %v, want %v", got.Time, start.Time)
+	}
+}
+
+// TestBusinessCalendarInvalidWeekend tests that weekdays outside Sunday
+// through Saturday are ignored
+func TestBusinessCalendarInvalidWeekend(t *testing.T) {
+	cal := NewBusinessCalendar(stdtime.UTC, stdtime.Weekday(-1), stdtime.Sunday, stdtime.Weekday(7))
+
+	sunday := Date(2024, 4, 14, 0, 0, 0, 0, stdtime.UTC)
+	if cal.IsBusinessDay(sunday) {
+		t.Error("IsBusinessDay(Sunday) = true, want false")
+	}
+	saturday := Date(2024, 4, 13, 0, 0, 0, 0, stdtime.UTC)
+	if !cal.IsBusinessDay(saturday) {
+		t.Error("IsBusinessDay(Saturday) = false, want true")
+	}
+}
@@ -0,0 +1,353 @@
+// Package time provides calendar helpers for week numbering, period
+// boundaries, and other date arithmetic that must respect the time's era.
+package time
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	stdtime "time"
+)
+
+// WeekScheme selects the rules used to number weeks within a year.
+type WeekScheme int
+
+const (
+	// WeekSchemeISO numbers weeks per ISO 8601: weeks start on Monday and
+	// week 1 is the week containing the year's first Thursday. Days in early
+	// January may belong to the last week of the previous week-year, and days
+	// in late December to week 1 of the next.
+	WeekSchemeISO WeekScheme = iota
+
+	// WeekSchemeSunday numbers weeks starting on Sunday, with week 1 being
+	// the week containing January 1. Weeks never cross year boundaries.
+	WeekSchemeSunday
+)
+
+// EraWeek returns the week number of t under the given scheme, together with
+// the year that week belongs to expressed in t's era. For WeekSchemeISO the
+// year is the ISO week-year, so a BE time on 1 January 2022 (which falls in
+// ISO week 52 of 2021) returns (2564, 52).
+func (t Time) EraWeek(scheme WeekScheme) (eraYear, week int) {
+	era := t.Era()
+
+	switch scheme {
+	case WeekSchemeSunday:
+		jan1Weekday := int(t.Time.AddDate(0, 0, 1-t.Time.YearDay()).Weekday())
+		week = (t.Time.YearDay()-1+jan1Weekday)/7 + 1
+		return era.FromCE(t.Time.Year()), week
+	default:
+		isoYear, isoWeek := t.Time.ISOWeek()
+		return era.FromCE(isoYear), isoWeek
+	}
+}
+
+// ISOWeek returns the ISO 8601 week-year and week number in which t occurs,
+// with the week-year expressed in t's era. Weeks range from 1 to 53.
+// Jan 01 to Jan 03 of year n might belong to week 52 or 53 of year n-1,
+// and Dec 29 to Dec 31 might belong to week 1 of year n+1, so a BE time on
+// 1 January 2022 returns (2564, 52).
+//
+// Use t.Time.ISOWeek() for the CE week-year.
+func (t Time) ISOWeek() (year, week int) {
+	return t.EraWeek(WeekSchemeISO)
+}
+
+// Quarter returns the quarter of the year in which t occurs, 1 to 4.
+// Quarters follow calendar months, so January to March is quarter 1.
+func (t Time) Quarter() int {
+	return (int(t.Time.Month())-1)/3 + 1
+}
+
+// WeekdayIndex returns the 0-based column of t's weekday in a week starting
+// on weekStart. With a Sunday start (as used in Thailand) Sunday is 0 and
+// Saturday is 6; with a Monday start (ISO 8601) Monday is 0 and Sunday is 6.
+func (t Time) WeekdayIndex(weekStart stdtime.Weekday) int {
+	return (int(t.Time.Weekday()) - int(weekStart) + 7) % 7
+}
+
+// isoWeekDateLayout describes the ISO 8601 week-date format in ParseError
+// messages, since it cannot be expressed as a Go layout.
+const isoWeekDateLayout = "YYYY-Www-D"
+
+// errInvalidISOWeekDate is the underlying error for malformed week dates.
+var errInvalidISOWeekDate = errors.New("invalid ISO week date")
+
+// ParseISOWeekDate parses an ISO 8601 week date such as "2024-W09-4"
+// (extended form) or "2024W094" (basic form) and returns the corresponding
+// Gregorian date at midnight UTC, with no era set (CE).
+//
+// The week must be between 1 and the number of ISO weeks in the year
+// (52 or 53), and the day between 1 (Monday) and 7 (Sunday).
+// Returns a ParseError if the value is malformed or out of range.
+func ParseISOWeekDate(value string) (Time, error) {
+	var yearStr, weekStr, dayStr string
+	switch {
+	case len(value) == 10 && value[4] == '-' && value[5] == 'W' && value[8] == '-':
+		yearStr, weekStr, dayStr = value[:4], value[6:8], value[9:]
+	case len(value) == 8 && value[4] == 'W':
+		yearStr, weekStr, dayStr = value[:4], value[5:7], value[7:]
+	default:
+		return Time{}, newParseError(value, isoWeekDateLayout, CE(), 0, errInvalidISOWeekDate)
+	}
+
+	year, err := parseISOWeekDateField(yearStr)
+	if err != nil {
+		return Time{}, newParseError(value, isoWeekDateLayout, CE(), 0, err)
+	}
+	week, err := parseISOWeekDateField(weekStr)
+	if err != nil {
+		return Time{}, newParseError(value, isoWeekDateLayout, CE(), 0, err)
+	}
+	day, err := parseISOWeekDateField(dayStr)
+	if err != nil {
+		return Time{}, newParseError(value, isoWeekDateLayout, CE(), 0, err)
+	}
+
+	if weeks := isoWeeksInYear(year); week < 1 || week > weeks {
+		return Time{}, newParseError(value, isoWeekDateLayout, CE(), 0,
+			fmt.Errorf("%w: week %d out of range 1-%d", errInvalidISOWeekDate, week, weeks))
+	}
+	if day < 1 || day > 7 {
+		return Time{}, newParseError(value, isoWeekDateLayout, CE(), 0,
+			fmt.Errorf("%w: day %d out of range 1-7", errInvalidISOWeekDate, day))
+	}
+
+	// January 4 is always in ISO week 1; find the Monday of that week
+	jan4 := stdtime.Date(year, stdtime.January, 4, 0, 0, 0, 0, stdtime.UTC)
+	daysSinceMonday := (int(jan4.Weekday()) + 6) % 7
+	date := jan4.AddDate(0, 0, -daysSinceMonday+(week-1)*7+(day-1))
+
+	return Time{Time: date, era: nil}, nil
+}
+
+// parseISOWeekDateField parses a fixed-width, digits-only week-date field.
+func parseISOWeekDateField(s string) (int, error) {
+	if strings.TrimLeft(s, "0123456789") != "" {
+		return 0, fmt.Errorf("%w: non-digit in %q", errInvalidISOWeekDate, s)
+	}
+	return strconv.Atoi(s)
+}
+
+// isoWeeksInYear returns the number of ISO 8601 weeks (52 or 53) in year.
+// December 28 always falls in the last ISO week of its year.
+func isoWeeksInYear(year int) int {
+	_, week := stdtime.Date(year, stdtime.December, 28, 0, 0, 0, 0, stdtime.UTC).ISOWeek()
+	return week
+}
+
+// StartOfDay returns midnight at the start of t's day in t's location,
+// keeping t's era.
+func (t Time) StartOfDay() Time {
+	y, m, d := t.Time.Date()
+	return Time{Time: stdtime.Date(y, m, d, 0, 0, 0, 0, t.Time.Location()), era: t.era}
+}
+
+// EndOfDay returns the last nanosecond (23:59:59.999999999) of t's day in
+// t's location, keeping t's era.
+func (t Time) EndOfDay() Time {
+	y, m, d := t.Time.Date()
+	return Time{Time: endBefore(stdtime.Date(y, m, d+1, 0, 0, 0, 0, t.Time.Location())), era: t.era}
+}
+
+// StartOfMonth returns midnight on the first day of t's month in t's
+// location, keeping t's era.
+func (t Time) StartOfMonth() Time {
+	y, m, _ := t.Time.Date()
+	return Time{Time: stdtime.Date(y, m, 1, 0, 0, 0, 0, t.Time.Location()), era: t.era}
+}
+
+// EndOfMonth returns the last nanosecond of t's month in t's location,
+// keeping t's era.
+func (t Time) EndOfMonth() Time {
+	y, m, _ := t.Time.Date()
+	return Time{Time: endBefore(stdtime.Date(y, m+1, 1, 0, 0, 0, 0, t.Time.Location())), era: t.era}
+}
+
+// StartOfYear returns midnight on January 1 of t's year in t's location,
+// keeping t's era. The year is the CE year, which for BE and other
+// offset-based eras starts on the same day as the era year.
+func (t Time) StartOfYear() Time {
+	return Time{Time: stdtime.Date(t.Time.Year(), stdtime.January, 1, 0, 0, 0, 0, t.Time.Location()), era: t.era}
+}
+
+// EndOfYear returns the last nanosecond of December 31 of t's year in t's
+// location, keeping t's era.
+func (t Time) EndOfYear() Time {
+	return Time{Time: endBefore(stdtime.Date(t.Time.Year()+1, stdtime.January, 1, 0, 0, 0, 0, t.Time.Location())), era: t.era}
+}
+
+// NthWeekdayOfMonth returns the nth wd of t's month in t's location, keeping
+// t's clock time and era. n counts from the start of the month for n > 0
+// (1 is the first) and from the end for n < 0 (-1 is the last):
+//
+//	t.NthWeekdayOfMonth(1, time.Monday)  // first Monday
+//	t.NthWeekdayOfMonth(-1, time.Friday) // last Friday
+//
+// If the month has no such day, such as a fifth Friday, or n is 0, the zero
+// Time is returned.
+func (t Time) NthWeekdayOfMonth(n int, wd stdtime.Weekday) Time {
+	y, m, _ := t.Time.Date()
+	last := lastDayOfMonth(y, m)
+
+	var day int
+	switch {
+	case n > 0:
+		first := stdtime.Date(y, m, 1, 0, 0, 0, 0, stdtime.UTC).Weekday()
+		day = 1 + (int(wd)-int(first)+7)%7 + (n-1)*7
+	case n < 0:
+		lastWeekday := stdtime.Date(y, m, last, 0, 0, 0, 0, stdtime.UTC).Weekday()
+		day = last - (int(lastWeekday)-int(wd)+7)%7 + (n+1)*7
+	}
+	if day < 1 || day > last {
+		return Time{}
+	}
+
+	hour, min, sec := t.Time.Clock()
+	return Time{Time: stdtime.Date(y, m, day, hour, min, sec, t.Time.Nanosecond(), t.Time.Location()), era: t.era}
+}
+
+// IsWeekend reports whether t falls on a Saturday or Sunday in t's
+// location. Use a BusinessCalendar for other weekend days.
+func (t Time) IsWeekend() bool {
+	wd := t.Time.Weekday()
+	return wd == stdtime.Saturday || wd == stdtime.Sunday
+}
+
+// daysInMonth holds the number of days in each month of a non-leap year.
+var daysInMonth = [...]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// DaysInMonth returns the number of days in t's month, 28 to 31. February
+// has 29 days when IsLeap reports a leap year.
+func (t Time) DaysInMonth() int {
+	month := t.Time.Month()
+	if month == stdtime.February && t.IsLeap() {
+		return 29
+	}
+	return daysInMonth[month-1]
+}
+
+// DaysInYear returns the number of days in t's year: 366 when IsLeap reports
+// a leap year and 365 otherwise.
+func (t Time) DaysInYear() int {
+	if t.IsLeap() {
+		return 366
+	}
+	return 365
+}
+
+// AddMonths returns t plus n months (minus if n is negative), keeping t's
+// era, clock time, and location.
+//
+// Unlike AddDate, which normalizes overflowing days (so January 31 plus one
+// month is March 2 or 3), AddMonths clamps the day to the last day of the
+// target month: January 31 plus one month is February 29 in a leap year and
+// February 28 otherwise.
+func (t Time) AddMonths(n int) Time {
+	y, m, d := t.Time.Date()
+	hour, min, sec := t.Time.Clock()
+
+	target := m + stdtime.Month(n)
+	if last := lastDayOfMonth(y, target); d > last {
+		d = last
+	}
+
+	return Time{Time: stdtime.Date(y, target, d, hour, min, sec, t.Time.Nanosecond(), t.Time.Location()), era: t.era}
+}
+
+// AddYears returns t plus n years (minus if n is negative), keeping t's era,
+// clock time, and location. Like AddMonths, the day is clamped to the end of
+// the target month, so February 29 plus one year is February 28.
+func (t Time) AddYears(n int) Time {
+	return t.AddMonths(12 * n)
+}
+
+// YearsBetween returns the number of completed years from from to to, such
+// as a person's age in full years given their birth date. A year is only
+// counted once its anniversary has been reached, comparing the calendar
+// dates of from and to in their own locations; eras are ignored.
+//
+// An anniversary on February 29 falls on February 28 in non-leap years.
+// If to is before from, the result is negative.
+func YearsBetween(from, to Time) int {
+	if to.Time.Before(from.Time) {
+		return -YearsBetween(to, from)
+	}
+
+	fy, fm, fd := from.Time.Date()
+	ty, tm, td := to.Time.Date()
+
+	// Clamp the anniversary to the end of the month (Feb 29 to Feb 28)
+	if last := lastDayOfMonth(ty, fm); fd > last {
+		fd = last
+	}
+
+	years := ty - fy
+	if tm < fm || (tm == fm && td < fd) {
+		years--
+	}
+	return years
+}
+
+// Age returns the number of completed years from t until now, as
+// YearsBetween(t, Now()).
+func (t Time) Age() int {
+	return YearsBetween(t, Now())
+}
+
+// lastDayOfMonth returns the last day of the given month. The month may be
+// outside 1-12; it is normalized into the adjacent years like time.Date.
+func lastDayOfMonth(year int, month stdtime.Month) int {
+	// Day 0 of the following month is the last day of this month
+	return stdtime.Date(year, month+1, 0, 0, 0, 0, 0, stdtime.UTC).Day()
+}
+
+// endBefore returns the instant one nanosecond before next, the start of the
+// following period. Working back from the next midnight keeps the result on
+// the correct wall clock even when the day is shortened or lengthened by a
+// daylight saving transition.
+func endBefore(next stdtime.Time) stdtime.Time {
+	return next.Add(-stdtime.Nanosecond)
+}
+
+// Range returns the times from start to end inclusive, spaced step apart,
+// each in start's era. It returns nil if step is not positive or end is
+// before start.
+//
+// The step is an elapsed duration, so a 24-hour step moves by one wall-clock
+// day only when no daylight saving transition intervenes; use AddDate for
+// calendar steps such as months.
+//
+// Example:
+//
+//	for _, day := range Range(first, last, 24*time.Hour) {
+//		fmt.Println(day.FormatLocale("th-TH", "2 January 2006"))
+//	}
+func Range(start, end Time, step stdtime.Duration) []Time {
+	if step <= 0 || end.Time.Before(start.Time) {
+		return nil
+	}
+
+	times := make([]Time, 0, end.Time.Sub(start.Time)/step+1)
+	RangeFunc(start, end, step, func(t Time) bool {
+		times = append(times, t)
+		return true
+	})
+	return times
+}
+
+// RangeFunc calls f for each time from start to end inclusive, spaced step
+// apart and in start's era, stopping early if f returns false. It does not
+// call f if step is not positive or end is before start.
+func RangeFunc(start, end Time, step stdtime.Duration, f func(Time) bool) {
+	if step <= 0 {
+		return
+	}
+
+	for t := start; !t.Time.After(end.Time); t = t.Add(step) {
+		if !f(t) {
+			return
+		}
+	}
+}
@@ -0,0 +1,538 @@
+package time
+
+import (
+	"testing"
+	stdtime "time"
+)
+
+// TestEraWeek tests week numbering within the era year
+func TestEraWeek(t *testing.T) {
+	tests := []struct {
+		name         string
+		tm           Time
+		scheme       WeekScheme
+		expectedYear int
+		expectedWeek int
+	}{
+		// 1 January 2022 is a Saturday and belongs to ISO week 52 of 2021
+		{"ISO Jan 1 in previous week-year BE", Date(2022, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeISO, 2564, 52},
+		{"ISO Jan 1 in previous week-year CE", Date(2022, 1, 1, 0, 0, 0, 0, stdtime.UTC), WeekSchemeISO, 2021, 52},
+		// 1 January 2021 is a Friday and belongs to ISO week 53 of 2020
+		{"ISO week 53", Date(2021, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeISO, 2563, 53},
+		// 30 December 2024 is a Monday and starts ISO week 1 of 2025
+		{"ISO Dec 30 in next week-year", Date(2024, 12, 30, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeISO, 2568, 1},
+		{"ISO mid-year", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeISO, 2567, 9},
+		{"Sunday Jan 1", Date(2022, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeSunday, 2565, 1},
+		{"Sunday first Sunday starts week 2", Date(2022, 1, 2, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeSunday, 2565, 2},
+		{"Sunday Dec 31", Date(2024, 12, 31, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), WeekSchemeSunday, 2567, 53},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := tt.tm.EraWeek(tt.scheme)
+			if year != tt.expectedYear || week != tt.expectedWeek {
+				t.Errorf("EraWeek() = (%d, %d), want (%d, %d)", year, week, tt.expectedYear, tt.expectedWeek)
+			}
+		})
+	}
+}
+
+// TestParseISOWeekDate tests parsing of ISO 8601 week dates
+func TestParseISOWeekDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected stdtime.Time
+	}{
+		{"2024-W09-4", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		{"2024W094", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		{"2024-W01-1", stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"2021-W52-6", stdtime.Date(2022, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"2020-W53-5", stdtime.Date(2021, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"2025-W01-1", stdtime.Date(2024, 12, 30, 0, 0, 0, 0, stdtime.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			result, err := ParseISOWeekDate(tt.value)
+			if err != nil {
+				t.Fatalf("ParseISOWeekDate(%q) unexpected error: %v", tt.value, err)
+			}
+			if !result.Time.Equal(tt.expected) {
+				t.Errorf("ParseISOWeekDate(%q) = %v, want %v", tt.value, result.Time, tt.expected)
+			}
+			if !result.IsCE() {
+				t.Errorf("ParseISOWeekDate(%q) era = %v, want CE", tt.value, result.Era())
+			}
+		})
+	}
+}
+
+// TestParseISOWeekDateInvalid tests that invalid week dates are rejected
+func TestParseISOWeekDateInvalid(t *testing.T) {
+	tests := []string{
+		"2024-W00-1", // week zero
+		"2024-W53-1", // 2024 has 52 ISO weeks
+		"2024-W54-1", // week out of range
+		"2024-W09-0", // day zero
+		"2024-W09-8", // day out of range
+		"2024-09-4",  // missing W
+		"2024-Wa9-4", // non-digit
+		"24-W09-4",   // short year
+		"",
+	}
+
+	for _, value := range tests {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseISOWeekDate(value)
+			if err == nil {
+				t.Fatalf("ParseISOWeekDate(%q) expected error", value)
+			}
+			if !IsParseError(err) {
+				t.Errorf("ParseISOWeekDate(%q) error type = %T, want *ParseError", value, err)
+			}
+		})
+	}
+}
+
+// TestWeekdayIndex tests weekday indices relative to the week start
+func TestWeekdayIndex(t *testing.T) {
+	// 28 February 2024 is a Wednesday; 25 February is a Sunday
+	wednesday := Date(2024, 2, 28, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	sunday := Date(2024, 2, 25, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		name      string
+		tm        Time
+		weekStart stdtime.Weekday
+		expected  int
+	}{
+		{"Wednesday Sunday-start", wednesday, stdtime.Sunday, 3},
+		{"Wednesday Monday-start", wednesday, stdtime.Monday, 2},
+		{"Sunday Sunday-start", sunday, stdtime.Sunday, 0},
+		{"Sunday Monday-start", sunday, stdtime.Monday, 6},
+		{"Wednesday Saturday-start", wednesday, stdtime.Saturday, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.WeekdayIndex(tt.weekStart); got != tt.expected {
+				t.Errorf("WeekdayIndex(%v) = %d, want %d", tt.weekStart, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestPeriodBoundaries tests day, month, and year boundary helpers
+func TestPeriodBoundaries(t *testing.T) {
+	tm := Date(2024, 2, 29, 15, 30, 45, 123, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		name     string
+		got      Time
+		expected stdtime.Time
+	}{
+		{"StartOfDay", tm.StartOfDay(), stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		{"EndOfDay", tm.EndOfDay(), stdtime.Date(2024, 2, 29, 23, 59, 59, 999999999, stdtime.UTC)},
+		{"StartOfMonth", tm.StartOfMonth(), stdtime.Date(2024, 2, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"EndOfMonth", tm.EndOfMonth(), stdtime.Date(2024, 2, 29, 23, 59, 59, 999999999, stdtime.UTC)},
+		{"StartOfYear", tm.StartOfYear(), stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"EndOfYear", tm.EndOfYear(), stdtime.Date(2024, 12, 31, 23, 59, 59, 999999999, stdtime.UTC)},
+		{"EndOfMonth December", Date(2023, 12, 5, 0, 0, 0, 0, stdtime.UTC).EndOfMonth(), stdtime.Date(2023, 12, 31, 23, 59, 59, 999999999, stdtime.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Time.Equal(tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got.Time, tt.expected)
+			}
+		})
+	}
+
+	if got := tm.StartOfYear(); got.Era() != BE() || got.Year() != 2567 {
+		t.Errorf("StartOfYear() = %v in %v, want year 2567 in BE", got.Time, got.Era())
+	}
+}
+
+// TestPeriodBoundariesDST tests that day boundaries follow the wall clock on
+// daylight saving transition days
+func TestPeriodBoundariesDST(t *testing.T) {
+	loc, err := stdtime.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Skipf("Failed to load location Australia/Sydney: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		date       Time
+		dayLength  stdtime.Duration
+		wantOffset [2]int // UTC offsets in hours at start and end of day
+	}{
+		// Clocks go back from 03:00 to 02:00: a 25-hour day
+		{"DST ends", Date(2024, 4, 7, 12, 0, 0, 0, loc), 25 * stdtime.Hour, [2]int{11, 10}},
+		// Clocks go forward from 02:00 to 03:00: a 23-hour day
+		{"DST starts", Date(2024, 10, 6, 12, 0, 0, 0, loc), 23 * stdtime.Hour, [2]int{10, 11}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := tt.date.InEra(BE()).StartOfDay()
+			end := tt.date.InEra(BE()).EndOfDay()
+
+			if h, m, s := start.Clock(); h != 0 || m != 0 || s != 0 || start.Nanosecond() != 0 {
+				t.Errorf("StartOfDay() = %v, want 00:00:00 wall clock", start.Time)
+			}
+			if h, m, s := end.Clock(); h != 23 || m != 59 || s != 59 || end.Nanosecond() != 999999999 {
+				t.Errorf("EndOfDay() = %v, want 23:59:59.999999999 wall clock", end.Time)
+			}
+			if start.Day() != tt.date.Day() || end.Day() != tt.date.Day() {
+				t.Errorf("boundaries %v - %v not on day %d", start.Time, end.Time, tt.date.Day())
+			}
+			if got := end.Sub(start) + stdtime.Nanosecond; got != tt.dayLength {
+				t.Errorf("day length = %v, want %v", got, tt.dayLength)
+			}
+			_, startOffset := start.Zone()
+			_, endOffset := end.Zone()
+			if startOffset != tt.wantOffset[0]*3600 || endOffset != tt.wantOffset[1]*3600 {
+				t.Errorf("offsets = %d, %d, want %dh, %dh", startOffset, endOffset, tt.wantOffset[0], tt.wantOffset[1])
+			}
+			if start.Era() != BE() || end.Era() != BE() {
+				t.Errorf("eras = %v, %v, want BE", start.Era(), end.Era())
+			}
+		})
+	}
+}
+
+// TestDaysInMonthAndYear tests month and year lengths with leap years
+func TestDaysInMonthAndYear(t *testing.T) {
+	tests := []struct {
+		name        string
+		tm          Time
+		daysInMonth int
+		daysInYear  int
+	}{
+		{"February leap year", Date(2024, 2, 10, 0, 0, 0, 0, stdtime.UTC), 29, 366},
+		{"February leap year in BE", Date(2024, 2, 10, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 29, 366},
+		{"February non-leap year", Date(2023, 2, 10, 0, 0, 0, 0, stdtime.UTC), 28, 365},
+		{"February century non-leap year", Date(1900, 2, 1, 0, 0, 0, 0, stdtime.UTC), 28, 365},
+		{"February 400-year leap year", Date(2000, 2, 1, 0, 0, 0, 0, stdtime.UTC), 29, 366},
+		{"December", Date(2023, 12, 31, 0, 0, 0, 0, stdtime.UTC), 31, 365},
+		{"April", Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC), 30, 366},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.DaysInMonth(); got != tt.daysInMonth {
+				t.Errorf("DaysInMonth() = %d, want %d", got, tt.daysInMonth)
+			}
+			if got := tt.tm.DaysInYear(); got != tt.daysInYear {
+				t.Errorf("DaysInYear() = %d, want %d", got, tt.daysInYear)
+			}
+		})
+	}
+}
+
+// TestNthWeekdayOfMonth tests finding the nth weekday of a month
+func TestNthWeekdayOfMonth(t *testing.T) {
+	// February 2024 starts on a Thursday and has 29 days
+	tm := Date(2024, 2, 14, 9, 30, 0, 0, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		name     string
+		n        int
+		wd       stdtime.Weekday
+		expected int // day of February, 0 if none
+	}{
+		{"First Monday", 1, stdtime.Monday, 5},
+		{"Last Monday", -1, stdtime.Monday, 26},
+		{"First Thursday is the 1st", 1, stdtime.Thursday, 1},
+		{"Last Thursday is the 29th", -1, stdtime.Thursday, 29},
+		{"Fifth Thursday", 5, stdtime.Thursday, 29},
+		{"Second to last Friday", -2, stdtime.Friday, 16},
+		{"Fifth Friday does not exist", 5, stdtime.Friday, 0},
+		{"Fifth to last Friday does not exist", -5, stdtime.Friday, 0},
+		{"Zero", 0, stdtime.Friday, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tm.NthWeekdayOfMonth(tt.n, tt.wd)
+			if tt.expected == 0 {
+				if !got.IsZero() {
+					t.Errorf("NthWeekdayOfMonth(%d, %v) = %v, want zero Time", tt.n, tt.wd, got.Time)
+				}
+				return
+			}
+			if expected := stdtime.Date(2024, 2, tt.expected, 9, 30, 0, 0, stdtime.UTC); !got.Time.Equal(expected) {
+				t.Errorf("NthWeekdayOfMonth(%d, %v) = %v, want %v", tt.n, tt.wd, got.Time, expected)
+			}
+			if got.Era() != BE() {
+				t.Errorf("NthWeekdayOfMonth(%d, %v).Era() = %v, want BE", tt.n, tt.wd, got.Era())
+			}
+		})
+	}
+}
+
+// TestIsWeekend tests Saturday and Sunday detection
+func TestIsWeekend(t *testing.T) {
+	tests := []struct {
+		tm       Time
+		expected bool
+	}{
+		{Date(2024, 2, 23, 12, 0, 0, 0, stdtime.UTC), false}, // Friday
+		{Date(2024, 2, 24, 12, 0, 0, 0, stdtime.UTC), true},  // Saturday
+		{Date(2024, 2, 25, 12, 0, 0, 0, stdtime.UTC).InEra(BE()), true},
+		{Date(2024, 2, 26, 0, 0, 0, 0, stdtime.UTC), false}, // Monday
+		// Sunday 23:00 UTC is Monday in Bangkok
+		{Date(2024, 2, 25, 23, 0, 0, 0, stdtime.UTC).In(stdtime.FixedZone("ICT", 7*60*60)), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tm.IsWeekend(); got != tt.expected {
+			t.Errorf("IsWeekend(%v) = %v, want %v", tt.tm.Time, got, tt.expected)
+		}
+	}
+}
+
+// TestISOWeek tests era-aware ISO week-years around the year boundary
+func TestISOWeek(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		wantYear int
+		wantWeek int
+	}{
+		{"BE Jan 1 in previous week-year", Date(2022, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 2564, 52},
+		{"BE Jan 3 first week", Date(2022, 1, 3, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 2565, 1},
+		{"BE Dec 30 in next week-year", Date(2024, 12, 30, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 2568, 1},
+		{"BE Dec 28 week 53", Date(2020, 12, 28, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 2563, 53},
+		{"CE Jan 1", Date(2022, 1, 1, 0, 0, 0, 0, stdtime.UTC), 2021, 52},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := tt.tm.ISOWeek()
+			if year != tt.wantYear || week != tt.wantWeek {
+				t.Errorf("ISOWeek() = (%d, %d), want (%d, %d)", year, week, tt.wantYear, tt.wantWeek)
+			}
+		})
+	}
+}
+
+// TestQuarter tests quarter numbers at quarter edges
+func TestQuarter(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		expected int
+	}{
+		{"Jan 1", Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC), 1},
+		{"Mar 31", Date(2024, 3, 31, 23, 59, 59, 999999999, stdtime.UTC), 1},
+		{"Apr 1", Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC), 2},
+		{"Jun 30", Date(2024, 6, 30, 0, 0, 0, 0, stdtime.UTC), 2},
+		{"Jul 1 BE", Date(2024, 7, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 3},
+		{"Sep 30", Date(2024, 9, 30, 0, 0, 0, 0, stdtime.UTC), 3},
+		{"Oct 1", Date(2024, 10, 1, 0, 0, 0, 0, stdtime.UTC), 4},
+		{"Dec 31", Date(2024, 12, 31, 0, 0, 0, 0, stdtime.UTC), 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.Quarter(); got != tt.expected {
+				t.Errorf("Quarter() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestAddMonthsClamping tests month and year arithmetic with end-of-month clamping
+func TestAddMonthsClamping(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      Time
+		expected stdtime.Time
+	}{
+		{"Jan 31 + 1 month leap", Date(2024, 1, 31, 10, 0, 0, 0, stdtime.UTC).AddMonths(1), stdtime.Date(2024, 2, 29, 10, 0, 0, 0, stdtime.UTC)},
+		{"Jan 31 + 1 month non-leap", Date(2023, 1, 31, 10, 0, 0, 0, stdtime.UTC).AddMonths(1), stdtime.Date(2023, 2, 28, 10, 0, 0, 0, stdtime.UTC)},
+		{"Mar 31 - 1 month", Date(2024, 3, 31, 0, 0, 0, 0, stdtime.UTC).AddMonths(-1), stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		{"May 31 - 1 month", Date(2024, 5, 31, 0, 0, 0, 0, stdtime.UTC).AddMonths(-1), stdtime.Date(2024, 4, 30, 0, 0, 0, 0, stdtime.UTC)},
+		{"Jan 15 - 13 months", Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC).AddMonths(-13), stdtime.Date(2022, 12, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"Oct 31 + 4 months across year", Date(2023, 10, 31, 0, 0, 0, 0, stdtime.UTC).AddMonths(4), stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		{"Zero months", Date(2024, 1, 31, 0, 0, 0, 0, stdtime.UTC).AddMonths(0), stdtime.Date(2024, 1, 31, 0, 0, 0, 0, stdtime.UTC)},
+		{"Feb 29 + 1 year", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).AddYears(1), stdtime.Date(2025, 2, 28, 0, 0, 0, 0, stdtime.UTC)},
+		{"Feb 29 + 4 years", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).AddYears(4), stdtime.Date(2028, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		{"Feb 29 - 1 year", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).AddYears(-1), stdtime.Date(2023, 2, 28, 0, 0, 0, 0, stdtime.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Time.Equal(tt.expected) {
+				t.Errorf("got %v, want %v", tt.got.Time, tt.expected)
+			}
+		})
+	}
+
+	beTime := Date(2024, 1, 31, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	if got := beTime.AddMonths(1); got.Era() != BE() || got.Year() != 2567 {
+		t.Errorf("AddMonths(1) = %v in %v, want year 2567 in BE", got.Time, got.Era())
+	}
+	if got := beTime.AddYears(-1); got.Era() != BE() || got.Year() != 2566 {
+		t.Errorf("AddYears(-1) = %v in %v, want year 2566 in BE", got.Time, got.Era())
+	}
+}
+
+// TestYearsBetween tests completed-year counting around anniversaries
+func TestYearsBetween(t *testing.T) {
+	birth := Date(1990, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	leapBirth := Date(2000, 2, 29, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		from     Time
+		to       Time
+		expected int
+	}{
+		{"Birthday today", birth, Date(2024, 6, 15, 9, 0, 0, 0, stdtime.UTC), 34},
+		{"Day before birthday", birth, Date(2024, 6, 14, 23, 59, 0, 0, stdtime.UTC), 33},
+		{"Day after birthday", birth, Date(2024, 6, 16, 0, 0, 0, 0, stdtime.UTC), 34},
+		{"Same day", birth, birth, 0},
+		{"Feb 29 on Feb 28 non-leap year", leapBirth, Date(2023, 2, 28, 0, 0, 0, 0, stdtime.UTC), 23},
+		{"Feb 29 on Feb 27 non-leap year", leapBirth, Date(2023, 2, 27, 0, 0, 0, 0, stdtime.UTC), 22},
+		{"Feb 29 on Feb 28 leap year", leapBirth, Date(2024, 2, 28, 0, 0, 0, 0, stdtime.UTC), 23},
+		{"Feb 29 on Feb 29 leap year", leapBirth, Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), 24},
+		{"Reversed", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC), birth, -34},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := YearsBetween(tt.from, tt.to); got != tt.expected {
+				t.Errorf("YearsBetween() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestAge tests age relative to the current time
+func TestAge(t *testing.T) {
+	now := Now()
+
+	tests := []struct {
+		name     string
+		birth    Time
+		expected int
+	}{
+		{"Birthday today", now.AddYears(-30).StartOfDay(), 30},
+		{"Birthday tomorrow", now.AddYears(-30).StartOfDay().AddDate(0, 0, 1), 29},
+		{"Birthday yesterday", now.AddYears(-30).StartOfDay().AddDate(0, 0, -1), 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.birth.Month() == stdtime.February && tt.birth.Day() == 29 {
+				t.Skip("February 29 anniversaries are clamped in non-leap years")
+			}
+			if got := tt.birth.Age(); got != tt.expected {
+				t.Errorf("Age() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRange tests stepping through a range across month and DST boundaries
+func TestRange(t *testing.T) {
+	loc, err := stdtime.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("Failed to load location America/New_York: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		start     Time
+		end       Time
+		step      stdtime.Duration
+		wantCount int
+		wantLast  stdtime.Time
+	}{
+		{
+			"Days across month boundary",
+			Date(2024, 2, 27, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), Date(2024, 3, 2, 0, 0, 0, 0, stdtime.UTC),
+			24 * stdtime.Hour, 5, stdtime.Date(2024, 3, 2, 0, 0, 0, 0, stdtime.UTC),
+		},
+		{
+			// Clocks go forward at 02:00 on 10 March, so 24-hour steps land at 01:00 after it
+			"Days across DST start",
+			Date(2024, 3, 9, 0, 0, 0, 0, loc).InEra(BE()), Date(2024, 3, 12, 0, 0, 0, 0, loc),
+			24 * stdtime.Hour, 3, stdtime.Date(2024, 3, 11, 1, 0, 0, 0, loc),
+		},
+		{
+			"End not on a step",
+			Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), Date(2024, 1, 1, 2, 30, 0, 0, stdtime.UTC),
+			stdtime.Hour, 3, stdtime.Date(2024, 1, 1, 2, 0, 0, 0, stdtime.UTC),
+		},
+		{
+			"Start equals end",
+			Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+			stdtime.Hour, 1, stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Range(tt.start, tt.end, tt.step)
+			if len(got) != tt.wantCount {
+				t.Fatalf("len(Range()) = %d, want %d", len(got), tt.wantCount)
+			}
+			if last := got[len(got)-1]; !last.Time.Equal(tt.wantLast) {
+				t.Errorf("Range() last = %v, want %v", last.Time, tt.wantLast)
+			}
+			for i, tm := range got {
+				if tm.Era() != BE() {
+					t.Errorf("Range()[%d].Era() = %v, want BE", i, tm.Era())
+				}
+			}
+		})
+	}
+}
+
+// TestRangeInvalid tests that empty ranges and non-positive steps yield nothing
+func TestRangeInvalid(t *testing.T) {
+	start := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+	end := Date(2024, 1, 10, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name  string
+		start Time
+		end   Time
+		step  stdtime.Duration
+	}{
+		{"Zero step", start, end, 0},
+		{"Negative step", start, end, -stdtime.Hour},
+		{"End before start", end, start, stdtime.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Range(tt.start, tt.end, tt.step); got != nil {
+				t.Errorf("Range() = %v, want nil", got)
+			}
+			RangeFunc(tt.start, tt.end, tt.step, func(Time) bool {
+				t.Error("RangeFunc() called f, want no calls")
+				return false
+			})
+		})
+	}
+}
+
+// TestRangeFuncStops tests that RangeFunc stops when f returns false
+func TestRangeFuncStops(t *testing.T) {
+	start := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+	end := Date(2024, 1, 31, 0, 0, 0, 0, stdtime.UTC)
+
+	calls := 0
+	RangeFunc(start, end, 24*stdtime.Hour, func(tm Time) bool {
+		calls++
+		return tm.Day() < 3
+	})
+	if calls != 3 {
+		t.Errorf("RangeFunc() calls = %d, want 3", calls)
+	}
+}
@@ -241,7 +241,7 @@ func TestConcurrentCacheClear(t *testing.T) {
 }
 
 // TestConcurrentReferenceDateModification tests concurrent modification
-// of reference dates for deterministic behavior.
+// of the era detection reference date for deterministic behavior.
 func TestConcurrentReferenceDateModification(t *testing.T) {
 	const numGoroutines = 20
 	const numIterations = 50
@@ -252,24 +252,25 @@ func TestConcurrentReferenceDateModification(t *testing.T) {
 		go func(id int) {
 			defer wg.Done()
 			for j := 0; j < numIterations; j++ {
-				// Set reference dates
+				// Every reference date is in 2024, so results must not change
 				refDate := stdtime.Date(2024, stdtime.Month(j%12+1), 15, 0, 0, 0, 0, stdtime.UTC)
 				SetEraDetectionReferenceDate(refDate)
-				SetYearFormatReferenceDate(refDate)
 
-				// Use the reference dates
 				tm := Date(2024, 6, 15, 12, 0, 0, 0, stdtime.UTC).InEra(BE())
-				_ = tm.Format("2006")
-				_ = DetectEraFromYear(2567)
+				if got := tm.Format("2006"); got != "2567" {
+					t.Errorf("Format(2006) = %q, want %q", got, "2567")
+				}
+				if got := DetectEraFromYear(2567); got != BE() {
+					t.Errorf("DetectEraFromYear(2567) = %v, want BE", got)
+				}
 			}
 		}(i)
 	}
 
 	wg.Wait()
 
-	// Clear reference dates at the end
+	// Clear the reference date at the end
 	SetEraDetectionReferenceDate(stdtime.Time{})
-	SetYearFormatReferenceDate(stdtime.Time{})
 }
 
 // TestConcurrentStringReplacerAccess tests concurrent access to StringReplacer.
@@ -450,3 +451,55 @@ func BenchmarkConcurrentFormat(b *testing.B) {
 		}
 	})
 }
+
+// TestConcurrentUnregisterEra verifies that concurrent register and
+// unregister calls are thread-safe.
+func TestConcurrentUnregisterEra(t *testing.T) {
+	const numGoroutines = 50
+	const numIterations = 50
+
+	var wg sync.WaitGroup
+	for i := 0; i < numGoroutines; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < numIterations; j++ {
+				era := RegisterEraWithOptions(EraOptions{
+					Name:   "ConcurrentUnregisterEra",
+					Offset: 100,
+					Family: "ConcurrentUnregisterFamily",
+				})
+				if era == nil {
+					t.Errorf("RegisterEraWithOptions returned nil")
+					return
+				}
+				_ = RegisterEraTransition("ConcurrentUnregisterFamily", era, stdtime.Date(2000, 1, 1, 0, 0, 0, 0, stdtime.UTC))
+				_ = Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(era).Year()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < numIterations; j++ {
+				UnregisterEra("ConcurrentUnregisterEra")
+				_ = GetEraForDate(stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC), "ConcurrentUnregisterFamily")
+				_ = EraFamilyNames()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Register once more so the final unregister also sweeps transitions
+	// left behind by interleaved register/unregister calls
+	RegisterEra("ConcurrentUnregisterEra", 100)
+	if !UnregisterEra("ConcurrentUnregisterEra") {
+		t.Error("UnregisterEra() = false for a registered era, want true")
+	}
+
+	if GetEra("ConcurrentUnregisterEra") != nil {
+		t.Error("era should be removed after final UnregisterEra")
+	}
+	if len(GetEraTransitions("ConcurrentUnregisterFamily")) != 0 {
+		t.Error("transitions should be removed after final UnregisterEra")
+	}
+}
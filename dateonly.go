@@ -0,0 +1,127 @@
+// Package time provides DateOnly, a calendar date without a time of day or
+// location, for values such as birthdays and holidays.
+package time
+
+import (
+	stdtime "time"
+)
+
+// DateOnly is a calendar date (year, month, day) with an era used for
+// rendering the year. Unlike Time it has no clock time or location, so two
+// DateOnly values compare equal whenever they name the same day, even if the
+// instants they were taken from differ.
+//
+// The zero value is not a valid date; use NewDateOnly or FromTime.
+type DateOnly struct {
+	year  int // CE year
+	month stdtime.Month
+	day   int
+	era   *Era
+}
+
+// NewDateOnly returns the date with the given CE year, month and day and no
+// era set (defaults to CE). Out-of-range values are normalized as in
+// time.Date, so 31 April becomes 1 May.
+func NewDateOnly(year int, month stdtime.Month, day int) DateOnly {
+	y, m, d := stdtime.Date(year, month, day, 0, 0, 0, 0, stdtime.UTC).Date()
+	return DateOnly{year: y, month: m, day: d}
+}
+
+// FromTime returns the calendar date of t in t's own location, keeping t's
+// era. Convert t with In first to take the date in another location.
+func FromTime(t Time) DateOnly {
+	y, m, d := t.Time.Date()
+	return DateOnly{year: y, month: m, day: d, era: t.era}
+}
+
+// Year returns the year in Common Era, regardless of the date's era.
+func (d DateOnly) Year() int {
+	return d.year
+}
+
+// Month returns the month of the year.
+func (d DateOnly) Month() stdtime.Month {
+	return d.month
+}
+
+// Day returns the day of the month.
+func (d DateOnly) Day() int {
+	return d.day
+}
+
+// Era returns the era of the date. If no era is set, CE is returned.
+func (d DateOnly) Era() *Era {
+	if d.era == nil {
+		return CE()
+	}
+	return d.era
+}
+
+// InEra returns a copy of d with the given era. The calendar date is
+// unchanged; only the rendering of the year differs.
+func (d DateOnly) InEra(era *Era) DateOnly {
+	d.era = era
+	return d
+}
+
+// At returns the Time at midnight of d in loc, keeping d's era.
+// If loc is nil, time.Local is used.
+func (d DateOnly) At(loc *stdtime.Location) Time {
+	if loc == nil {
+		loc = stdtime.Local
+	}
+	return Time{Time: stdtime.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc), era: d.era}
+}
+
+// Compare compares the calendar dates d and u, ignoring their eras.
+// If d is before u, it returns -1; if d is after u, it returns +1;
+// if they're the same day, it returns 0.
+func (d DateOnly) Compare(u DateOnly) int {
+	switch {
+	case d.year != u.year:
+		return compareInts(d.year, u.year)
+	case d.month != u.month:
+		return compareInts(int(d.month), int(u.month))
+	}
+	return compareInts(d.day, u.day)
+}
+
+// compareInts returns -1, 0 or +1 as a is less than, equal to or greater
+// than b.
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+// Equal reports whether d and u are the same calendar date, regardless of
+// their eras.
+func (d DateOnly) Equal(u DateOnly) bool {
+	return d.Compare(u) == 0
+}
+
+// Before reports whether d is an earlier calendar date than u.
+func (d DateOnly) Before(u DateOnly) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is a later calendar date than u.
+func (d DateOnly) After(u DateOnly) bool {
+	return d.Compare(u) > 0
+}
+
+// Format returns the date formatted according to layout, with the year
+// adjusted to d's era as in Time.Format. Clock elements of the layout render
+// midnight and zone elements render UTC.
+func (d DateOnly) Format(layout string) string {
+	return d.At(stdtime.UTC).Format(layout)
+}
+
+// String returns the date formatted as "2006-01-02" in its era.
+func (d DateOnly) String() string {
+	return d.Format("2006-01-02")
+}
@@ -0,0 +1,104 @@
+package time
+
+import (
+	"testing"
+	stdtime "time"
+)
+
+// TestDateOnlyAcrossTimezones tests that dates compare by calendar day, not
+// by instant
+func TestDateOnlyAcrossTimezones(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	newYork := stdtime.FixedZone("EST", -5*60*60)
+
+	// The same instant falls on different days in Bangkok and New York
+	instant := stdtime.Date(2024, 4, 13, 2, 0, 0, 0, stdtime.UTC)
+	inBangkok := FromTime(FromStd(instant.In(bangkok)))
+	inNewYork := FromTime(FromStd(instant.In(newYork)))
+
+	if inBangkok.Equal(inNewYork) {
+		t.Errorf("FromTime() of one instant in ICT and EST = %v, %v; want different dates", inBangkok, inNewYork)
+	}
+	if !inNewYork.Before(inBangkok) || !inBangkok.After(inNewYork) {
+		t.Errorf("Before/After(%v, %v) = %v, %v; want true, true", inNewYork, inBangkok, inNewYork.Before(inBangkok), inBangkok.After(inNewYork))
+	}
+
+	// Different instants fall on the same day in their own locations
+	morning := FromTime(Date(2024, 4, 13, 1, 0, 0, 0, bangkok))
+	evening := FromTime(Date(2024, 4, 13, 23, 0, 0, 0, newYork).InEra(BE()))
+	if !morning.Equal(evening) {
+		t.Errorf("Equal(%v, %v) = false, want true", morning, evening)
+	}
+	if morning.Before(evening) || morning.After(evening) || morning.Compare(evening) != 0 {
+		t.Errorf("Before/After/Compare of equal dates = %v, %v, %d", morning.Before(evening), morning.After(evening), morning.Compare(evening))
+	}
+}
+
+// TestDateOnlyCompare tests ordering of calendar dates
+func TestDateOnlyCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        DateOnly
+		b        DateOnly
+		expected int
+	}{
+		{"Earlier year", NewDateOnly(2023, stdtime.December, 31), NewDateOnly(2024, stdtime.January, 1), -1},
+		{"Later month", NewDateOnly(2024, stdtime.March, 1), NewDateOnly(2024, stdtime.February, 29), +1},
+		{"Earlier day", NewDateOnly(2024, stdtime.April, 12), NewDateOnly(2024, stdtime.April, 13), -1},
+		{"Same day different eras", NewDateOnly(2024, stdtime.April, 13).InEra(BE()), NewDateOnly(2024, stdtime.April, 13), 0},
+		{"Normalized", NewDateOnly(2024, stdtime.April, 31), NewDateOnly(2024, stdtime.May, 1), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.expected {
+				t.Errorf("Compare(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDateOnlyFormat tests era-aware formatting of dates
+func TestDateOnlyFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     DateOnly
+		layout   string
+		expected string
+	}{
+		{"CE", NewDateOnly(2024, stdtime.February, 29), "02/01/2006", "29/02/2024"},
+		{"BE", NewDateOnly(2024, stdtime.February, 29).InEra(BE()), "02/01/2006", "29/02/2567"},
+		{"ROC", NewDateOnly(2024, stdtime.February, 29).InEra(ROC()), "2006-01-02", "0113-02-29"},
+		{"Clock elements render midnight", NewDateOnly(2024, stdtime.February, 29), "2006-01-02 15:04 MST", "2024-02-29 00:00 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Format(tt.layout); got != tt.expected {
+				t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+
+	if got := NewDateOnly(2024, stdtime.February, 29).InEra(BE()).String(); got != "2567-02-29" {
+		t.Errorf("String() = %q, want %q", got, "2567-02-29")
+	}
+}
+
+// TestDateOnlyAt tests conversion back to a Time at midnight
+func TestDateOnlyAt(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+
+	date := FromTime(Date(2024, 4, 13, 18, 45, 0, 0, bangkok).InEra(BE()))
+	got := date.At(bangkok)
+
+	if expected := stdtime.Date(2024, 4, 13, 0, 0, 0, 0, bangkok); !got.Time.Equal(expected) {
+		t.Errorf("At(ICT) = %v, want %v", got.Time, expected)
+	}
+	if got.Era() != BE() {
+		t.Errorf("At(ICT).Era() = %v, want BE", got.Era())
+	}
+	if !FromTime(got).Equal(date) {
+		t.Errorf("FromTime(At(ICT)) = %v, want %v", FromTime(got), date)
+	}
+}
@@ -28,6 +28,8 @@ package time
 
 import (
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	stdtime "time"
 
@@ -93,6 +95,10 @@ const (
 	// of the Common Era calendar.
 	BEOffset = 543
 
+	// ROCOffset is the number of years to add to a Common Era year to get
+	// the corresponding Republic of China (Minguo) year. Minguo 1 is CE 1912.
+	ROCOffset = -1911
+
 	// DefaultEraFamily is the default calendar family for simple eras.
 	DefaultEraFamily = "Common"
 )
@@ -104,6 +110,8 @@ type EraFormat struct {
 	Prefix string
 
 	// Suffix is the string to append after the year (e.g., "年" for Japanese).
+	// It is not repeated when the layout already has it after the year, as
+	// in "2006年01月02日".
 	Suffix string
 
 	// YearDigits specifies the number of digits to use for the year.
@@ -114,6 +122,10 @@ type EraFormat struct {
 	// Japanese eras use 1-based (元年 = year 1), not 0-based.
 	ZeroBased bool
 
+	// Gannen renders the first year of the era as "元" instead of "1",
+	// as in Japanese 令和元年.
+	Gannen bool
+
 	// FullFormat is an optional custom format string for the full era date.
 	// If set, this takes precedence over Prefix/YearDigits/Suffix.
 	// The format uses the same layout strings as time.Time.Format.
@@ -168,8 +180,24 @@ type EraOptions struct {
 }
 
 var (
-	ce = &Era{name: "CE", offset: 0}
-	be = &Era{name: "BE", offset: BEOffset}
+	ce = &Era{name: "CE", offset: 0, family: DefaultEraFamily}
+	be = &Era{name: "BE", offset: BEOffset, family: DefaultEraFamily}
+
+	roc = &Era{
+		name:      "ROC",
+		offset:    ROCOffset,
+		startDate: stdtime.Date(1912, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+		family:    "Chinese",
+		locale:    "zh-TW",
+		format: &EraFormat{
+			Prefix: "民國",
+			Suffix: "年",
+		},
+		names: map[string]string{
+			"zh-TW": "民國",
+			"en-US": "ROC",
+		},
+	}
 
 	eras   = make(map[string]*Era)
 	erasMu sync.RWMutex
@@ -179,6 +207,10 @@ var (
 	detectionReferenceDate stdtime.Time
 	detectionMu            sync.RWMutex
 
+	// detectionWindow is the range of plausible CE years set with
+	// SetEraDetectionWindow, guarded by detectionMu.
+	detectionWindow eraDetectionWindow
+
 	// familyTransitions maps family name to era transitions.
 	// Each family can have multiple transitions (e.g., Japanese eras).
 	familyTransitions = make(map[string][]*EraTransition)
@@ -196,9 +228,31 @@ type EraTransition struct {
 	start stdtime.Time
 }
 
+// Era returns the era that begins at this transition.
+func (et *EraTransition) Era() *Era {
+	return et.era
+}
+
+// Start returns the instant at which the transition's era begins.
+func (et *EraTransition) Start() stdtime.Time {
+	return et.start
+}
+
 func init() {
-	RegisterEra("CE", 0)
-	RegisterEra("BE", BEOffset)
+	ce.names = map[string]string{
+		LocaleThTH: thaiCEAbbreviation,
+		"en-US":    "CE",
+	}
+	be.names = map[string]string{
+		LocaleThTH: thaiBEAbbreviation,
+		"en-US":    "BE",
+	}
+
+	erasMu.Lock()
+	eras[ce.name] = ce
+	eras[be.name] = be
+	eras[roc.name] = roc
+	erasMu.Unlock()
 }
 
 // CE returns the Common Era (CE) era instance. Common Era is the
@@ -214,6 +268,13 @@ func BE() *Era {
 	return be
 }
 
+// ROC returns the Republic of China (Minguo) era instance, used in Taiwan.
+// Minguo years count from the founding of the Republic in CE 1912, so
+// CE 2024 is Minguo 113.
+func ROC() *Era {
+	return roc
+}
+
 // String returns the era's name, such as "CE" or "BE".
 func (e *Era) String() string {
 	return e.name
@@ -237,6 +298,19 @@ func (e *Era) ToCE(eraYear int) int {
 	return eraYear - e.offset
 }
 
+// Equal reports whether e and other represent the same era. Two eras are
+// equal if they are the same instance or have the same name and offset,
+// so eras registered separately with identical definitions compare equal.
+func (e *Era) Equal(other *Era) bool {
+	if e == other {
+		return true
+	}
+	if e == nil || other == nil {
+		return false
+	}
+	return e.name == other.name && e.offset == other.offset
+}
+
 // StartDate returns the date when this era begins.
 // Returns zero time if the era has no specific start date.
 func (e *Era) StartDate() stdtime.Time {
@@ -284,6 +358,21 @@ func (e *Era) NameForLocale(locale string) string {
 	return e.name
 }
 
+// NameForLocaleOrScript returns the era name localized for the given locale,
+// falling back to fallbackLocale and then to the default era name.
+// This supports multi-script chains such as Thai, then English, then name.
+func (e *Era) NameForLocaleOrScript(locale, fallbackLocale string) string {
+	if e.names != nil {
+		if name, ok := e.names[locale]; ok {
+			return name
+		}
+		if name, ok := e.names[fallbackLocale]; ok {
+			return name
+		}
+	}
+	return e.name
+}
+
 // IsValidForDate checks if this era was active at the given date.
 // For eras with no start/end dates, this always returns true.
 // For eras with only a start date, returns true if date >= startDate.
@@ -327,11 +416,11 @@ func RegisterEra(name string, offset int) *Era {
 		return eras[name]
 	}
 
-	era := &Era{name: name, offset: offset}
+	era := &Era{name: name, offset: offset, family: DefaultEraFamily}
 	eras[name] = era
 
 	// Clear the global era cache to ensure consistency with new era
-	globalEraCache.Clear()
+	eraCache().Clear()
 
 	return era
 }
@@ -342,7 +431,7 @@ func RegisterEra(name string, offset int) *Era {
 //
 // The options parameter must have a non-empty Name. If an era with the same
 // name already exists, it returns the existing era without applying new options.
-// To update an existing era, first unregister it (if supported) or use a new name.
+// To update an existing era, use UpdateEraWithOptions.
 //
 // This function is thread-safe and clears the era cache to ensure consistency.
 //
@@ -377,6 +466,17 @@ func RegisterEraWithOptions(options EraOptions) *Era {
 		return existing
 	}
 
+	era := newEraFromOptions(options)
+	eras[options.Name] = era
+
+	// Clear the global era cache to ensure consistency with new era
+	eraCache().Clear()
+
+	return era
+}
+
+// newEraFromOptions returns a new era configured by options.
+func newEraFromOptions(options EraOptions) *Era {
 	era := &Era{
 		name:      options.Name,
 		offset:    options.Offset,
@@ -392,13 +492,195 @@ func RegisterEraWithOptions(options EraOptions) *Era {
 	if era.family == "" {
 		era.family = DefaultEraFamily
 	}
+	return era
+}
+
+// isBuiltinEraName reports whether name refers to an era shipped with the
+// package, which cannot be updated or unregistered.
+func isBuiltinEraName(name string) bool {
+	return name == ce.name || name == be.name || name == roc.name
+}
+
+// UpdateEraWithOptions replaces the configuration of an already registered
+// era, identified by options.Name, with the given options. This allows
+// fixing a prefix or adding localized names after registration.
+//
+// Registered eras are never modified, so the update registers a new *Era
+// under the same name and returns it. Family transitions and locale default
+// eras referring to the old era are moved to the new one, and GetEra returns
+// the new one. Existing Time values keep the era they hold; use
+// t.InEra(GetEra(name)) to format them with the new settings.
+//
+// Returns a ValidationError if the name is empty, not registered, or refers
+// to a built-in era ("CE", "BE", or "ROC"). The era cache is cleared on success.
+func UpdateEraWithOptions(options EraOptions) (*Era, error) {
+	if options.Name == "" {
+		return nil, newValidationError(ErrCodeInvalidEra, "name", options.Name, "era name must not be empty")
+	}
+	if isBuiltinEraName(options.Name) {
+		return nil, newValidationError(ErrCodeInvalidEra, "name", options.Name, "built-in eras cannot be updated")
+	}
 
+	erasMu.Lock()
+	defer erasMu.Unlock()
+
+	old, exists := eras[options.Name]
+	if !exists {
+		return nil, newValidationError(ErrCodeInvalidEra, "name", options.Name, "era is not registered")
+	}
+
+	era := newEraFromOptions(options)
 	eras[options.Name] = era
 
-	// Clear the global era cache to ensure consistency with new era
-	globalEraCache.Clear()
+	// Transitions are immutable and may be shared with snapshots, so
+	// replace the ones referring to the old era
+	for family, transitions := range familyTransitions {
+		updated := make([]*EraTransition, len(transitions))
+		for i, t := range transitions {
+			updated[i] = t
+			if t.era == old {
+				updated[i] = &EraTransition{era: era, start: t.start}
+			}
+		}
+		familyTransitions[family] = updated
+	}
 
-	return era
+	detectionMu.Lock()
+	for locale, defaultEra := range localeDefaultEras {
+		if defaultEra == old {
+			localeDefaultEras[locale] = era
+		}
+	}
+	detectionMu.Unlock()
+
+	eraCache().Clear()
+
+	return era, nil
+}
+
+// UnregisterEra removes a previously registered era by name and reports
+// whether it existed. The built-in "CE", "BE", and "ROC" eras cannot be removed.
+//
+// Any family transitions and locale defaults referring to an era with this
+// name are removed as well, and the era cache is cleared. This is mainly useful
+// for test isolation and for applications that manage eras dynamically.
+//
+// This function is thread-safe.
+func UnregisterEra(name string) bool {
+	if isBuiltinEraName(name) {
+		return false
+	}
+
+	erasMu.Lock()
+	defer erasMu.Unlock()
+
+	if _, exists := eras[name]; !exists {
+		return false
+	}
+	delete(eras, name)
+
+	// Match by name so references to earlier registrations under the same
+	// name are removed too
+	for family, transitions := range familyTransitions {
+		kept := transitions[:0]
+		for _, t := range transitions {
+			if t.era == nil || t.era.name != name {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 0 {
+			delete(familyTransitions, family)
+		} else {
+			familyTransitions[family] = kept
+		}
+	}
+
+	detectionMu.Lock()
+	for locale, defaultEra := range localeDefaultEras {
+		if defaultEra != nil && defaultEra.name == name {
+			delete(localeDefaultEras, locale)
+		}
+	}
+	detectionMu.Unlock()
+
+	eraCache().Clear()
+
+	return true
+}
+
+// EraRegistrySnapshot is a copy of the era registry taken by
+// SnapshotEraRegistry, for restoring with RestoreEraRegistry.
+type EraRegistrySnapshot struct {
+	eras           map[string]*Era
+	transitions    map[string][]*EraTransition
+	localeDefaults map[string]*Era
+}
+
+// SnapshotEraRegistry returns a copy of the registered eras, the era
+// transitions of every family, and the locale default
+// eras. It is meant for tests that register eras, so that they can undo
+// their changes:
+//
+//	defer RestoreEraRegistry(SnapshotEraRegistry())
+func SnapshotEraRegistry() EraRegistrySnapshot {
+	erasMu.RLock()
+	defer erasMu.RUnlock()
+	detectionMu.RLock()
+	defer detectionMu.RUnlock()
+
+	snapshot := EraRegistrySnapshot{
+		eras:           make(map[string]*Era, len(eras)),
+		transitions:    copyTransitions(familyTransitions),
+		localeDefaults: make(map[string]*Era, len(localeDefaultEras)),
+	}
+	for name, era := range eras {
+		snapshot.eras[name] = era
+	}
+	for locale, era := range localeDefaultEras {
+		snapshot.localeDefaults[locale] = era
+	}
+	return snapshot
+}
+
+// RestoreEraRegistry restores the era registry to the state captured by
+// snapshot: eras registered since are removed, unregistered eras come back,
+// and eras updated with UpdateEraWithOptions are registered under their
+// earlier *Era again. Transitions and locale default eras are restored too,
+// and the era cache is cleared. A snapshot may be restored more than once.
+//
+// Registered eras are never modified, so restoring only swaps the registry
+// under its locks and is safe while other goroutines format or parse times.
+// Eras registered by RegisterJapaneseEras after the snapshot are removed,
+// and a later call registers them again.
+func RestoreEraRegistry(snapshot EraRegistrySnapshot) {
+	erasMu.Lock()
+	defer erasMu.Unlock()
+
+	eras = make(map[string]*Era, len(snapshot.eras))
+	for name, era := range snapshot.eras {
+		eras[name] = era
+	}
+	familyTransitions = copyTransitions(snapshot.transitions)
+
+	detectionMu.Lock()
+	localeDefaultEras = make(map[string]*Era, len(snapshot.localeDefaults))
+	for locale, era := range snapshot.localeDefaults {
+		localeDefaultEras[locale] = era
+	}
+	detectionMu.Unlock()
+
+	eraCache().Clear()
+}
+
+// copyTransitions returns a copy of transitions whose slices can be changed
+// without affecting the original. The transitions themselves are immutable
+// and shared.
+func copyTransitions(transitions map[string][]*EraTransition) map[string][]*EraTransition {
+	copied := make(map[string][]*EraTransition, len(transitions))
+	for family, list := range transitions {
+		copied[family] = append([]*EraTransition(nil), list...)
+	}
+	return copied
 }
 
 // RegisterEraTransition registers a transition between two eras within a family.
@@ -409,27 +691,36 @@ func RegisterEraWithOptions(options EraOptions) *Era {
 // startDate belong to the previous era; dates at or after startDate belong
 // to the new era.
 //
+// Returns a ValidationError if newEra is nil or the family already has a
+// transition starting at the same instant, since GetEraForDate could not
+// choose between them.
+//
 // This function is thread-safe.
 func RegisterEraTransition(family string, newEra *Era, startDate stdtime.Time) error {
+	if newEra == nil {
+		return newValidationError(ErrCodeInvalidEra, "era", newEra, "transition era must not be nil")
+	}
+
 	erasMu.Lock()
 	defer erasMu.Unlock()
 
-	// Get or create family transitions map
-	if familyTransitions[family] == nil {
-		familyTransitions[family] = make([]*EraTransition, 0)
+	// Insert in start order; i is the first transition not before startDate
+	transitions := familyTransitions[family]
+	i := sort.Search(len(transitions), func(i int) bool {
+		return !transitions[i].start.Before(startDate)
+	})
+	if i < len(transitions) && transitions[i].start.Equal(startDate) {
+		return newValidationError(ErrCodeInvalidEra, "startDate", startDate,
+			"family "+family+" already has a transition to "+transitions[i].era.String()+" at this date")
 	}
 
-	// Add new transition
-	transition := &EraTransition{
+	transitions = append(transitions, nil)
+	copy(transitions[i+1:], transitions[i:])
+	transitions[i] = &EraTransition{
 		era:   newEra,
 		start: startDate,
 	}
-	familyTransitions[family] = append(familyTransitions[family], transition)
-
-	// Sort transitions by start date
-	sort.Slice(familyTransitions[family], func(i, j int) bool {
-		return familyTransitions[family][i].start.Before(familyTransitions[family][j].start)
-	})
+	familyTransitions[family] = transitions
 
 	return nil
 }
@@ -461,6 +752,54 @@ func GetEraForDate(date stdtime.Time, family string) *Era {
 	return activeEra
 }
 
+// YearForDateInFamily finds the era in effect on date within family, using
+// the transitions registered with RegisterEraTransition, and returns it with
+// the 1-based era year counted from the transition's start year.
+// Returns ok=false if no transition covers the date.
+//
+// Example:
+//
+//	RegisterJapaneseEras()
+//	era, year, ok := YearForDateInFamily(date, "Japanese") // Reiwa, 6, true for 2024
+func YearForDateInFamily(date stdtime.Time, family string) (era *Era, eraYear int, ok bool) {
+	erasMu.RLock()
+	defer erasMu.RUnlock()
+
+	var active *EraTransition
+	for _, t := range familyTransitions[family] {
+		if date.Before(t.start) {
+			break
+		}
+		active = t
+	}
+	if active == nil {
+		return nil, 0, false
+	}
+
+	return active.era, date.In(active.start.Location()).Year() - active.start.Year() + 1, true
+}
+
+// EraYearsElapsed describes the span between two dates in terms of the eras
+// of a family, such as "Heisei 30 to Reiwa 6" for 2018 to 2024 in the
+// Japanese family. Era names are localized with NameForLocale, so locale
+// "ja-JP" yields "平成 30 to 令和 6".
+//
+// Returns an empty string if either date is not covered by a transition
+// registered for the family.
+func EraYearsElapsed(from, to stdtime.Time, family, locale string) string {
+	fromEra, fromYear, ok := YearForDateInFamily(from, family)
+	if !ok {
+		return ""
+	}
+	toEra, toYear, ok := YearForDateInFamily(to, family)
+	if !ok {
+		return ""
+	}
+
+	return fromEra.NameForLocale(locale) + " " + strconv.Itoa(fromYear) +
+		" to " + toEra.NameForLocale(locale) + " " + strconv.Itoa(toYear)
+}
+
 // GetEraTransitions returns all registered transitions for a family.
 // The transitions are sorted by start date.
 func GetEraTransitions(family string) []*EraTransition {
@@ -498,34 +837,162 @@ func SetEraDetectionReferenceDate(t stdtime.Time) {
 // This is useful when you want to release memory or when custom eras
 // have been registered and you want to ensure cache consistency.
 func ClearEraCache() {
-	globalEraCache.Clear()
+	eraCache().Clear()
+}
+
+// SetEraCacheSize replaces the global era cache with an empty cache that
+// holds at most n year conversions, evicting the least recently used entry
+// beyond that. Cached entries and statistics are discarded. If n <= 0, the
+// default size (1024) is used.
+//
+// Services that convert thousands of distinct year and era combinations
+// can raise the size to avoid repeated evictions.
+func SetEraCacheSize(n int) {
+	eraCacheMu.Lock()
+	defer eraCacheMu.Unlock()
+	globalEraCache.Store(internal.NewEraCacheWithTTL(n, eraCache().TTL()))
+}
+
+// SetEraCacheTTL replaces the global era cache with an empty cache, of the
+// same size, whose entries expire ttl after they are stored. Expired entries
+// are recomputed on the next lookup. If ttl <= 0, entries never expire,
+// which is the default.
+//
+// Registering, updating or unregistering an era already clears the cache,
+// so expiry is not needed for correctness; it bounds how long a conversion
+// is reused by long-running processes.
+func SetEraCacheTTL(ttl stdtime.Duration) {
+	eraCacheMu.Lock()
+	defer eraCacheMu.Unlock()
+	globalEraCache.Store(internal.NewEraCacheWithTTL(eraCache().MaxSize(), ttl))
+}
+
+// EraCacheSize returns the maximum number of entries in the global era cache.
+func EraCacheSize() int {
+	return eraCache().MaxSize()
 }
 
 // EraCacheStats returns the current statistics for the global era cache.
 // This can be used to monitor cache effectiveness.
 func EraCacheStats() internal.CacheStats {
-	return globalEraCache.Stats()
+	return eraCache().Stats()
+}
+
+// EraCacheStatsByEra returns the global era cache statistics partitioned by
+// era name, for the eras that have been looked up since the cache was last
+// cleared. Distinct eras registered under the same name share an entry.
+func EraCacheStatsByEra() map[string]internal.CacheStats {
+	byPointer := eraCache().StatsByEra()
+	result := make(map[string]internal.CacheStats, len(byPointer))
+	for ptr, stats := range byPointer {
+		name := (*Era)(ptr).String() //nolint:gosec
+		sum := result[name]
+		sum.Hits += stats.Hits
+		sum.Misses += stats.Misses
+		sum.Evictions += stats.Evictions
+		result[name] = sum
+	}
+	return result
 }
 
 // EraCacheHitRate returns the hit rate of the global era cache as a percentage.
 func EraCacheHitRate() float64 {
-	return globalEraCache.HitRate()
+	return eraCache().HitRate()
 }
 
 // DetectEraFromYear determines which era (CE or BE) the given year is most
 // likely to belong to based on proximity to the reference date. This is useful
 // for Thai date parsing where the era may not be explicitly specified.
 // The reference date is configurable via SetEraDetectionReferenceDate for testing.
+//
+// If a window is set with SetEraDetectionWindow, years inside it are CE and
+// years whose CE equivalent is inside it are BE; proximity decides the rest.
+//
+// Proximity is only a heuristic. CE and BE years 543 apart are equally
+// plausible in isolation, and the crossover lies halfway between the current
+// CE and BE years (about 2295 in 2024): a historical CE year such as 2480 is
+// reported as BE, and a far-past BE year such as 2200 (1657 CE) as CE. Use
+// DetectEraFromYearWithHint when the plausible range of CE years is known.
 func DetectEraFromYear(year int) *Era {
+	return detectEraFromYearAt(year, stdtime.Time{})
+}
+
+// detectEraFromYearAt implements DetectEraFromYear with refDate as the
+// reference date. If refDate is zero, the date set with
+// SetEraDetectionReferenceDate or else time.Now() is used.
+func detectEraFromYearAt(year int, refDate stdtime.Time) *Era {
 	detectionMu.RLock()
-	refDate := detectionReferenceDate
+	if refDate.IsZero() {
+		refDate = detectionReferenceDate
+	}
+	window := detectionWindow
 	detectionMu.RUnlock()
 
+	if window.set {
+		switch {
+		case window.ceMin <= year && year <= window.ceMax:
+			return CE()
+		case window.ceMin <= BE().ToCE(year) && BE().ToCE(year) <= window.ceMax:
+			return BE()
+		}
+	}
+
 	currentTime := refDate
 	if currentTime.IsZero() {
 		currentTime = stdtime.Now()
 	}
-	currentCEYear := currentTime.Year()
+	return detectEraFromYear(year, currentTime.Year())
+}
+
+// eraDetectionWindow is a range of CE years used by DetectEraFromYear.
+type eraDetectionWindow struct {
+	ceMin, ceMax int
+	set          bool
+}
+
+// SetEraDetectionWindow sets the range [ceMin, ceMax] of CE years that
+// inputs are expected to contain. DetectEraFromYear then reports CE for
+// years inside the window and BE for years that fall inside it once
+// converted from BE, such as 2567 for a window containing 2024. Other years
+// are still detected by proximity. Choose a window narrower than 543 years
+// so that no year matches as both.
+//
+// The window applies to all era detection in the process, including
+// ParseWithLocale. Use ClearEraDetectionWindow to remove it.
+func SetEraDetectionWindow(ceMin, ceMax int) {
+	detectionMu.Lock()
+	defer detectionMu.Unlock()
+	detectionWindow = eraDetectionWindow{ceMin: ceMin, ceMax: ceMax, set: true}
+}
+
+// ClearEraDetectionWindow removes the window set with SetEraDetectionWindow,
+// restoring pure proximity-based detection.
+func ClearEraDetectionWindow() {
+	detectionMu.Lock()
+	defer detectionMu.Unlock()
+	detectionWindow = eraDetectionWindow{}
+}
+
+// DetectEraFromYearWithHint is like DetectEraFromYear, but reports CE for
+// any year within [minCEYear, maxCEYear], the range of CE years the data is
+// known to contain. Years outside the window fall back to proximity-based
+// detection. If minCEYear > maxCEYear the window is empty.
+//
+// Example:
+//
+//	// Historical records dated up to 2600 CE
+//	DetectEraFromYearWithHint(2480, 1800, 2600) // CE
+//	DetectEraFromYearWithHint(2480, 1800, 2100) // BE
+func DetectEraFromYearWithHint(year, minCEYear, maxCEYear int) *Era {
+	if minCEYear <= year && year <= maxCEYear {
+		return CE()
+	}
+	return DetectEraFromYear(year)
+}
+
+// detectEraFromYear returns BE if year is closer to the BE year of
+// currentCEYear than to currentCEYear itself, and CE otherwise.
+func detectEraFromYear(year, currentCEYear int) *Era {
 	currentBEYear := currentCEYear + BE().offset
 
 	ceDiff := absInt(year - currentCEYear)
@@ -549,16 +1016,20 @@ func absInt(x int) int {
 // to belong to, considering both the year value and the date context.
 // It also considers locale hints if available.
 //
-// This is more accurate than DetectEraFromYear when dealing with dates
-// that have explicit era information (e.g., parsed from Japanese dates).
+// The locale's default era (see SetLocaleDefaultEra) is returned if set.
+// Otherwise the year is detected as by DetectEraFromYear, but by proximity
+// to date rather than to the current time, such as the date a historical
+// record was written: year 2100 is CE for a record from 2090 CE and BE
+// (1557 CE) for a record from 1550 CE. If date is zero, the reference date
+// of DetectEraFromYear is used.
 func DetectEraFromYearAndDate(year int, date stdtime.Time, locale string) *Era {
 	// First check locale-specific defaults
 	if era := DetectEraForLocale(locale); era != nil {
 		return era
 	}
 
-	// Fall back to year-based detection
-	return DetectEraFromYear(year)
+	// Fall back to year-based detection relative to the date
+	return detectEraFromYearAt(year, date)
 }
 
 // SetLocaleDefaultEra sets the default era for a locale.
@@ -632,27 +1103,50 @@ func ListLocaleDefaultEras() map[string]*Era {
 	return result
 }
 
-// EraFamilyNames returns a list of all registered calendar family names.
+// EraFamilyNames returns the names of all calendar families with registered
+// eras, sorted, including DefaultEraFamily ("Common") of the built-in CE and
+// BE eras. Use UserEraFamilyNames to list only user-registered families.
 func EraFamilyNames() []string {
+	return eraFamilyNames(false)
+}
+
+// UserEraFamilyNames returns the names of the calendar families of eras
+// registered by the caller, sorted. DefaultEraFamily ("Common") and the
+// families of the built-in CE, BE and ROC eras are excluded unless another
+// era was registered in them, so "Chinese" is listed only alongside a
+// user-registered Chinese era.
+func UserEraFamilyNames() []string {
+	return eraFamilyNames(true)
+}
+
+// eraFamilyNames implements EraFamilyNames and UserEraFamilyNames.
+func eraFamilyNames(userOnly bool) []string {
 	erasMu.RLock()
 	defer erasMu.RUnlock()
 
 	families := make(map[string]bool)
-	for _, era := range eras {
-		if era.family != "" {
-			families[era.family] = true
+	for name, era := range eras {
+		if era.family == "" {
+			continue
+		}
+		if userOnly && (isBuiltinEraName(name) || era.family == DefaultEraFamily) {
+			continue
 		}
+		families[era.family] = true
 	}
 
 	result := make([]string, 0, len(families))
 	for family := range families {
 		result = append(result, family)
 	}
+	sort.Strings(result)
 
 	return result
 }
 
-// GetErasInFamily returns all eras belonging to a specific calendar family.
+// GetErasInFamily returns all eras belonging to a specific calendar family,
+// sorted by name. The built-in CE and BE eras belong to DefaultEraFamily
+// ("Common"), along with eras registered without a family.
 // Returns nil if no family with that name exists.
 func GetErasInFamily(family string) []*Era {
 	erasMu.RLock()
@@ -664,16 +1158,128 @@ func GetErasInFamily(family string) []*Era {
 			result = append(result, era)
 		}
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
 
 	return result
 }
 
 // IsValidYear checks if the given year is valid for this era.
-// BE era requires positive years (year > 0), while CE era accepts
+// BE and ROC eras require positive years (year > 0), while CE era accepts
 // zero and positive years.
 func (e *Era) IsValidYear(year int) bool {
-	if e == BE() {
+	if e == BE() || e == ROC() {
 		return year > 0
 	}
 	return year >= 0 // CE era accepts year 0 and positive years
 }
+
+// Region codes accepted by LoadRegion.
+const (
+	// RegionThailand loads the Buddhist Era as the default for th-TH.
+	RegionThailand = "TH"
+	// RegionTaiwan loads the Republic of China (Minguo) era as the default for zh-TW.
+	RegionTaiwan = "TW"
+	// RegionJapan loads the Japanese era family with its modern transitions.
+	RegionJapan = "JP"
+)
+
+// jstZone is Japan Standard Time, used for Japanese era transition dates.
+var jstZone = stdtime.FixedZone("JST", 9*60*60)
+
+var (
+	// regionLoaders maps a region code to the function registering its eras.
+	regionLoaders = map[string]func(){
+		RegionThailand: loadThailandRegion,
+		RegionTaiwan:   loadTaiwanRegion,
+		RegionJapan:    RegisterJapaneseEras,
+	}
+
+	// japaneseErasMu serializes RegisterJapaneseEras so that concurrent
+	// calls do not register the same era twice.
+	japaneseErasMu sync.Mutex
+)
+
+// LoadRegion registers the eras and locale defaults commonly used in the
+// given region. Region codes are ISO 3166-1 alpha-2 and case-insensitive:
+//
+//   - "TH" sets BE as the default era for th-TH
+//   - "TW" makes the ROC (Minguo) era the default for zh-TW
+//   - "JP" registers the Japanese era family (Meiji through Reiwa) and
+//     its transitions, for use with GetEraForDate(date, "Japanese")
+//
+// Loading a region more than once is safe. Returns a ValidationError if the
+// region is not supported.
+func LoadRegion(region string) error {
+	loader, ok := regionLoaders[strings.ToUpper(region)]
+	if !ok {
+		return newValidationError(ErrCodeInvalidEra, "region", region, "unsupported region code")
+	}
+	loader()
+	return nil
+}
+
+func loadThailandRegion() {
+	SetLocaleDefaultEra("th-TH", BE())
+}
+
+func loadTaiwanRegion() {
+	SetLocaleDefaultEra("zh-TW", ROC())
+}
+
+// RegisterJapaneseEras registers the modern Japanese eras (Meiji, Taishō,
+// Shōwa, Heisei and Reiwa) in the "Japanese" family along with their
+// transitions, so GetEraForDate(date, "Japanese") resolves the era in effect
+// on a given date. Transition dates are in Japan Standard Time.
+//
+// Each era formats as prefix + year + "年" with the first year rendered as
+// 元年 (gannen), e.g. "令和6年" or "昭和元年", and "2006年01月02日" renders as
+// "令和6年03月05日". Calling it more than once is safe.
+func RegisterJapaneseEras() {
+	japaneseErasMu.Lock()
+	defer japaneseErasMu.Unlock()
+
+	japaneseEras := []struct {
+		name   string
+		prefix string
+		start  stdtime.Time
+	}{
+		{"Meiji", "明治", stdtime.Date(1868, 10, 23, 0, 0, 0, 0, jstZone)},
+		{"Taisho", "大正", stdtime.Date(1912, 7, 30, 0, 0, 0, 0, jstZone)},
+		{"Showa", "昭和", stdtime.Date(1926, 12, 25, 0, 0, 0, 0, jstZone)},
+		{"Heisei", "平成", stdtime.Date(1989, 1, 8, 0, 0, 0, 0, jstZone)},
+		{"Reiwa", "令和", stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone)},
+	}
+
+	for i, je := range japaneseEras {
+		var end stdtime.Time
+		if i+1 < len(japaneseEras) {
+			end = japaneseEras[i+1].start
+		}
+
+		// Eras and transitions already in the registry are kept, so only
+		// the ones missing, such as after RestoreEraRegistry, are added
+		era := GetEra(je.name)
+		if era == nil {
+			era = RegisterEraWithOptions(EraOptions{
+				Name:      je.name,
+				Offset:    1 - je.start.Year(),
+				StartDate: je.start,
+				EndDate:   end,
+				Family:    "Japanese",
+				Locale:    "ja-JP",
+				Format: &EraFormat{
+					Prefix: je.prefix,
+					Suffix: "年",
+					Gannen: true,
+				},
+				Names: map[string]string{
+					"ja-JP": je.prefix,
+					"en-US": je.name,
+				},
+			})
+		}
+		_ = RegisterEraTransition("Japanese", era, je.start)
+	}
+}
@@ -1,9 +1,14 @@
 package time
 
 import (
+	"errors"
+	"sort"
+	"strings"
 	"sync"
 	"testing"
 	stdtime "time"
+
+	"github.com/bouroo/go-time/internal"
 )
 
 // TestEraConversionRealWorld tests era conversions with real-world scenarios
@@ -451,6 +456,9 @@ func TestEraTransitions(t *testing.T) {
 		Offset: 300,
 		Family: familyName,
 	})
+	defer UnregisterEra("Era1")
+	defer UnregisterEra("Era2")
+	defer UnregisterEra("Era3")
 
 	// Register transitions (out of order to test sorting)
 	err := RegisterEraTransition(familyName, era3, stdtime.Date(2030, 1, 1, 0, 0, 0, 0, stdtime.UTC))
@@ -496,6 +504,188 @@ func TestEraTransitions(t *testing.T) {
 	}
 }
 
+// TestEraTransitionAccessors tests that transitions registered out of order
+// are returned sorted with their eras and start dates
+func TestEraTransitionAccessors(t *testing.T) {
+	family := "AccessorFamily"
+	starts := []stdtime.Time{
+		stdtime.Date(2030, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+		stdtime.Date(2010, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+		stdtime.Date(2020, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+	}
+	names := []string{"AccessorEra2030", "AccessorEra2010", "AccessorEra2020"}
+
+	for i, name := range names {
+		era := RegisterEraWithOptions(EraOptions{Name: name, Offset: 1 - starts[i].Year(), Family: family})
+		defer UnregisterEra(name)
+		if err := RegisterEraTransition(family, era, starts[i]); err != nil {
+			t.Fatalf("RegisterEraTransition(%s) unexpected error: %v", name, err)
+		}
+	}
+
+	transitions := GetEraTransitions(family)
+	if len(transitions) != 3 {
+		t.Fatalf("len(GetEraTransitions()) = %d, want 3", len(transitions))
+	}
+
+	expected := []struct {
+		era   string
+		start stdtime.Time
+	}{
+		{"AccessorEra2010", starts[1]},
+		{"AccessorEra2020", starts[2]},
+		{"AccessorEra2030", starts[0]},
+	}
+	for i, tr := range transitions {
+		if tr.Era().String() != expected[i].era || !tr.Start().Equal(expected[i].start) {
+			t.Errorf("transition %d = (%v, %v), want (%s, %v)", i, tr.Era(), tr.Start(), expected[i].era, expected[i].start)
+		}
+	}
+}
+
+// TestRegisterEraTransitionErrors tests that nil eras and duplicate start
+// dates are rejected
+func TestRegisterEraTransitionErrors(t *testing.T) {
+	family := "DuplicateStartFamily"
+	start := stdtime.Date(2000, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+
+	first := RegisterEraWithOptions(EraOptions{Name: "DuplicateStartFirst", Offset: -1999, Family: family})
+	second := RegisterEraWithOptions(EraOptions{Name: "DuplicateStartSecond", Offset: -1999, Family: family})
+	defer UnregisterEra("DuplicateStartFirst")
+	defer UnregisterEra("DuplicateStartSecond")
+
+	if err := RegisterEraTransition(family, first, start); err != nil {
+		t.Fatalf("RegisterEraTransition() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		era     *Era
+		start   stdtime.Time
+		wantErr bool
+	}{
+		{"Duplicate start", second, start, true},
+		{"Duplicate start in another location", second, start.In(stdtime.FixedZone("ICT", 7*60*60)), true},
+		{"Nil era", nil, start.AddDate(1, 0, 0), true},
+		{"Valid later start", second, start.AddDate(10, 0, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterEraTransition(family, tt.era, tt.start)
+			if tt.wantErr && !IsValidationError(err) {
+				t.Errorf("RegisterEraTransition() error = %v, want ValidationError", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("RegisterEraTransition() unexpected error: %v", err)
+			}
+		})
+	}
+
+	transitions := GetEraTransitions(family)
+	if len(transitions) != 2 || transitions[0].Era() != first || transitions[1].Era() != second {
+		t.Errorf("GetEraTransitions() = %v, want transitions to %v then %v", transitions, first, second)
+	}
+	if got := GetEraForDate(start.AddDate(0, 6, 0), family); got != first {
+		t.Errorf("GetEraForDate() = %v, want %v", got, first)
+	}
+}
+
+// TestSnapshotEraRegistry tests that restoring a snapshot undoes registry
+// changes
+func TestSnapshotEraRegistry(t *testing.T) {
+	kept := RegisterEraWithOptions(EraOptions{Name: "SnapshotKeptEra", Offset: 100, Family: "SnapshotFamily"})
+	defer UnregisterEra("SnapshotKeptEra")
+	if err := RegisterEraTransition("SnapshotFamily", kept, stdtime.Date(2000, 1, 1, 0, 0, 0, 0, stdtime.UTC)); err != nil {
+		t.Fatalf("RegisterEraTransition() unexpected error: %v", err)
+	}
+
+	snapshot := SnapshotEraRegistry()
+
+	// Mutate every part of the registry
+	added := RegisterEraWithOptions(EraOptions{Name: "SnapshotAddedEra", Offset: 200, Family: "SnapshotFamily"})
+	if err := RegisterEraTransition("SnapshotFamily", added, stdtime.Date(2010, 1, 1, 0, 0, 0, 0, stdtime.UTC)); err != nil {
+		t.Fatalf("RegisterEraTransition() unexpected error: %v", err)
+	}
+	SetLocaleDefaultEra("xx-SNAP", added)
+	if _, err := UpdateEraWithOptions(EraOptions{Name: "SnapshotKeptEra", Offset: 300}); err != nil {
+		t.Fatalf("UpdateEraWithOptions() unexpected error: %v", err)
+	}
+	if got := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(GetEra("SnapshotKeptEra")).Year(); got != 2324 {
+		t.Fatalf("Year() after update = %d, want 2324", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		RestoreEraRegistry(snapshot)
+
+		if GetEra("SnapshotAddedEra") != nil {
+			t.Error("GetEra(SnapshotAddedEra) after restore should return nil")
+		}
+		if GetEra("SnapshotKeptEra") != kept {
+			t.Error("GetEra(SnapshotKeptEra) after restore should return the original era")
+		}
+		if transitions := GetEraTransitions("SnapshotFamily"); len(transitions) != 1 || transitions[0].Era() != kept {
+			t.Errorf("GetEraTransitions() after restore = %v, want only the transition to %v", transitions, kept)
+		}
+		if GetLocaleDefaultEra("xx-SNAP") != nil {
+			t.Error("GetLocaleDefaultEra(xx-SNAP) after restore should return nil")
+		}
+		if kept.Family() != "SnapshotFamily" {
+			t.Errorf("Family() after restore = %q, want SnapshotFamily", kept.Family())
+		}
+		if got := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(kept).Year(); got != 2124 {
+			t.Errorf("Year() after restore = %d, want 2124", got)
+		}
+
+		// Mutating after a restore must not change the snapshot
+		RegisterEraWithOptions(EraOptions{Name: "SnapshotAddedEra", Offset: 200})
+	}
+	RestoreEraRegistry(snapshot)
+}
+
+// TestRestoreEraRegistryConcurrentFormat tests restoring the registry while
+// other goroutines format BE times, under the race detector
+func TestRestoreEraRegistryConcurrentFormat(t *testing.T) {
+	snapshot := SnapshotEraRegistry()
+	defer RestoreEraRegistry(snapshot)
+	tm := Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+	stop := make(chan struct{})
+	var wg, started sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if got := tm.Format("2006-01-02"); got != "2567-06-15" {
+					t.Errorf("Format() during restore = %q, want %q", got, "2567-06-15")
+					return
+				}
+				_ = tm.FormatWithEraStyle("zh-TW", "2006")
+				_ = BE().FromCE(2024)
+			}
+		}()
+	}
+	started.Wait()
+	for i := 0; i < 50; i++ {
+		RegisterEraWithOptions(EraOptions{Name: "RestoreRaceEra", Offset: 100})
+		RestoreEraRegistry(snapshot)
+	}
+	close(stop)
+	wg.Wait()
+
+	if GetEra("RestoreRaceEra") != nil {
+		t.Error("GetEra(RestoreRaceEra) after restore should return nil")
+	}
+}
+
 // TestLocaleDefaultEra tests locale-to-era default mapping
 func TestLocaleDefaultEra(t *testing.T) {
 	// Create a test era
@@ -593,6 +783,84 @@ func TestGetErasInFamily(t *testing.T) {
 	}
 }
 
+// TestUserEraFamilyNames tests that built-in families are excluded and
+// names are sorted
+func TestUserEraFamilyNames(t *testing.T) {
+	RegisterEraWithOptions(EraOptions{Name: "UserFamilyZetaEra", Offset: 100, Family: "UserFamilyZeta"})
+	RegisterEraWithOptions(EraOptions{Name: "UserFamilyAlphaEra", Offset: 200, Family: "UserFamilyAlpha"})
+	RegisterEraWithOptions(EraOptions{Name: "UserFamilyCommonEra", Offset: 300})
+	defer UnregisterEra("UserFamilyZetaEra")
+	defer UnregisterEra("UserFamilyAlphaEra")
+	defer UnregisterEra("UserFamilyCommonEra")
+
+	user := UserEraFamilyNames()
+	if !sort.StringsAreSorted(user) {
+		t.Errorf("UserEraFamilyNames() = %v, want sorted", user)
+	}
+	found := make(map[string]bool)
+	for _, f := range user {
+		found[f] = true
+	}
+	for _, f := range []string{"UserFamilyAlpha", "UserFamilyZeta"} {
+		if !found[f] {
+			t.Errorf("UserEraFamilyNames() = %v, missing %q", user, f)
+		}
+	}
+	for _, f := range []string{DefaultEraFamily, ROC().Family()} {
+		if found[f] {
+			t.Errorf("UserEraFamilyNames() = %v, want %q excluded", user, f)
+		}
+	}
+
+	all := EraFamilyNames()
+	if !sort.StringsAreSorted(all) {
+		t.Errorf("EraFamilyNames() = %v, want sorted", all)
+	}
+	found = make(map[string]bool)
+	for _, f := range all {
+		found[f] = true
+	}
+	for _, f := range []string{DefaultEraFamily, ROC().Family(), "UserFamilyAlpha", "UserFamilyZeta"} {
+		if !found[f] {
+			t.Errorf("EraFamilyNames() = %v, missing %q", all, f)
+		}
+	}
+}
+
+// TestGetErasInFamilyOrdering tests that eras are returned sorted by name
+func TestGetErasInFamilyOrdering(t *testing.T) {
+	for _, name := range []string{"OrderingGamma", "OrderingAlpha", "OrderingBeta"} {
+		RegisterEraWithOptions(EraOptions{Name: name, Offset: 100, Family: "OrderingFamily"})
+		defer UnregisterEra(name)
+	}
+
+	var names []string
+	for _, era := range GetErasInFamily("OrderingFamily") {
+		names = append(names, era.String())
+	}
+	if got, want := strings.Join(names, ","), "OrderingAlpha,OrderingBeta,OrderingGamma"; got != want {
+		t.Errorf("GetErasInFamily(OrderingFamily) = %s, want %s", got, want)
+	}
+
+	// The built-in CE and BE eras are in the default family
+	common := GetErasInFamily(DefaultEraFamily)
+	hasCE, hasBE := false, false
+	for i, era := range common {
+		hasCE = hasCE || era == CE()
+		hasBE = hasBE || era == BE()
+		if i > 0 && common[i-1].String() > era.String() {
+			t.Errorf("GetErasInFamily(%s) not sorted at %d: %v before %v", DefaultEraFamily, i, common[i-1], era)
+		}
+	}
+	if !hasCE || !hasBE {
+		t.Errorf("GetErasInFamily(%s) has CE %v, BE %v; want both", DefaultEraFamily, hasCE, hasBE)
+	}
+	if CE().Family() != DefaultEraFamily || GetEra("CE") != CE() || GetEra("BE") != BE() {
+		t.Errorf("built-in eras: CE family %q, GetEra(CE) == CE() %v, GetEra(BE) == BE() %v",
+			CE().Family(), GetEra("CE") == CE(), GetEra("BE") == BE())
+	}
+}
+
 // TestIsValidForDate tests era date validity checking
 func TestIsValidForDate(t *testing.T) {
 	era := RegisterEraWithOptions(EraOptions{
@@ -641,3 +909,876 @@ func TestYearInEra(t *testing.T) {
 		t.Errorf("YearInEra(2024) = %d, want %d", yearInEra, expected)
 	}
 }
+
+// TestNameForLocaleOrScript tests the localized name fallback chain
+func TestNameForLocaleOrScript(t *testing.T) {
+	era := RegisterEraWithOptions(EraOptions{
+		Name:   "ScriptFallbackEra",
+		Offset: 2018,
+		Names: map[string]string{
+			"ja-JP": "令和",
+		},
+	})
+
+	tests := []struct {
+		name           string
+		locale         string
+		fallbackLocale string
+		expected       string
+	}{
+		{"Requested locale exists", "ja-JP", "en-US", "令和"},
+		{"Falls back to fallback locale", "th-TH", "ja-JP", "令和"},
+		{"Falls back to default name", "th-TH", "en-US", "ScriptFallbackEra"},
+		{"Empty locales", "", "", "ScriptFallbackEra"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := era.NameForLocaleOrScript(tt.locale, tt.fallbackLocale); got != tt.expected {
+				t.Errorf("NameForLocaleOrScript(%q, %q) = %q, want %q", tt.locale, tt.fallbackLocale, got, tt.expected)
+			}
+		})
+	}
+
+	// Built-in eras fall back from Thai to English names
+	if got := BE().NameForLocaleOrScript("th-TH", "en-US"); got != "พ.ศ." {
+		t.Errorf("BE().NameForLocaleOrScript(th-TH) = %q, want %q", got, "พ.ศ.")
+	}
+	if got := BE().NameForLocaleOrScript("de-DE", "en-US"); got != "BE" {
+		t.Errorf("BE().NameForLocaleOrScript(de-DE) = %q, want %q", got, "BE")
+	}
+}
+
+// TestLoadRegion tests loading era definitions by region code
+func TestLoadRegion(t *testing.T) {
+	t.Run("TH", func(t *testing.T) {
+		defer ClearLocaleDefaultEra("th-TH")
+
+		if err := LoadRegion("TH"); err != nil {
+			t.Fatalf("LoadRegion(TH) unexpected error: %v", err)
+		}
+		if got := GetLocaleDefaultEra("th-TH"); got != BE() {
+			t.Errorf("GetLocaleDefaultEra(th-TH) = %v, want BE", got)
+		}
+	})
+
+	t.Run("TW", func(t *testing.T) {
+		defer ClearLocaleDefaultEra("zh-TW")
+
+		if err := LoadRegion("tw"); err != nil {
+			t.Fatalf("LoadRegion(tw) unexpected error: %v", err)
+		}
+		if got := GetLocaleDefaultEra("zh-TW"); got != ROC() {
+			t.Errorf("GetLocaleDefaultEra(zh-TW) = %v, want ROC", got)
+		}
+	})
+
+	t.Run("JP", func(t *testing.T) {
+		// Loading twice must not duplicate transitions
+		for i := 0; i < 2; i++ {
+			if err := LoadRegion("JP"); err != nil {
+				t.Fatalf("LoadRegion(JP) unexpected error: %v", err)
+			}
+		}
+		if got := len(GetEraTransitions("Japanese")); got != 5 {
+			t.Errorf("len(GetEraTransitions(Japanese)) = %d, want 5", got)
+		}
+
+		tests := []struct {
+			date     stdtime.Time
+			expected string
+		}{
+			{stdtime.Date(1926, 12, 24, 0, 0, 0, 0, jstZone), "Taisho"},
+			{stdtime.Date(1926, 12, 25, 0, 0, 0, 0, jstZone), "Showa"},
+			{stdtime.Date(1989, 1, 7, 0, 0, 0, 0, jstZone), "Showa"},
+			{stdtime.Date(1989, 1, 8, 0, 0, 0, 0, jstZone), "Heisei"},
+			{stdtime.Date(2024, 6, 15, 0, 0, 0, 0, jstZone), "Reiwa"},
+		}
+		for _, tt := range tests {
+			era := GetEraForDate(tt.date, "Japanese")
+			if era == nil || era.String() != tt.expected {
+				t.Errorf("GetEraForDate(%v, Japanese) = %v, want %s", tt.date, era, tt.expected)
+			}
+		}
+
+		if got := GetEra("Reiwa").FromCE(2024); got != 6 {
+			t.Errorf("Reiwa.FromCE(2024) = %d, want 6", got)
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		err := LoadRegion("XX")
+		if err == nil {
+			t.Fatal("LoadRegion(XX) expected error")
+		}
+		if !IsValidationError(err) {
+			t.Errorf("LoadRegion(XX) error type = %T, want *ValidationError", err)
+		}
+	})
+}
+
+// TestEraYearsElapsed tests describing spans across era transitions
+func TestEraYearsElapsed(t *testing.T) {
+	if err := LoadRegion(RegionJapan); err != nil {
+		t.Fatalf("LoadRegion(JP) unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		from     stdtime.Time
+		to       stdtime.Time
+		locale   string
+		expected string
+	}{
+		{"Spanning Heisei to Reiwa", stdtime.Date(2018, 6, 1, 0, 0, 0, 0, jstZone), stdtime.Date(2024, 6, 1, 0, 0, 0, 0, jstZone), "en-US", "Heisei 30 to Reiwa 6"},
+		{"Spanning Showa to Heisei on boundary", stdtime.Date(1989, 1, 7, 0, 0, 0, 0, jstZone), stdtime.Date(1989, 1, 8, 0, 0, 0, 0, jstZone), "en-US", "Showa 64 to Heisei 1"},
+		{"Within Reiwa", stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone), stdtime.Date(2024, 6, 1, 0, 0, 0, 0, jstZone), "en-US", "Reiwa 1 to Reiwa 6"},
+		{"Japanese names", stdtime.Date(2018, 6, 1, 0, 0, 0, 0, jstZone), stdtime.Date(2024, 6, 1, 0, 0, 0, 0, jstZone), "ja-JP", "平成 30 to 令和 6"},
+		{"Before first transition", stdtime.Date(1800, 1, 1, 0, 0, 0, 0, jstZone), stdtime.Date(2024, 6, 1, 0, 0, 0, 0, jstZone), "en-US", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EraYearsElapsed(tt.from, tt.to, "Japanese", tt.locale); got != tt.expected {
+				t.Errorf("EraYearsElapsed() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if got := EraYearsElapsed(stdtime.Now(), stdtime.Now(), "NoSuchFamily", "en-US"); got != "" {
+		t.Errorf("EraYearsElapsed() for unknown family = %q, want empty", got)
+	}
+}
+
+// TestUnregisterEra tests removing registered eras
+func TestUnregisterEra(t *testing.T) {
+	era := RegisterEraWithOptions(EraOptions{
+		Name:   "UnregisterTestEra",
+		Offset: 100,
+		Family: "UnregisterTestFamily",
+	})
+	if err := RegisterEraTransition("UnregisterTestFamily", era, stdtime.Date(2000, 1, 1, 0, 0, 0, 0, stdtime.UTC)); err != nil {
+		t.Fatalf("RegisterEraTransition() unexpected error: %v", err)
+	}
+	SetLocaleDefaultEra("xx-UNREG", era)
+
+	if !UnregisterEra("UnregisterTestEra") {
+		t.Fatal("UnregisterEra() = false for a registered era, want true")
+	}
+	if GetEra("UnregisterTestEra") != nil {
+		t.Error("GetEra() after UnregisterEra() should return nil")
+	}
+	if len(GetEraTransitions("UnregisterTestFamily")) != 0 {
+		t.Error("transitions referring to the removed era should be removed")
+	}
+	if GetLocaleDefaultEra("xx-UNREG") != nil {
+		t.Error("locale defaults referring to the removed era should be removed")
+	}
+	for _, family := range EraFamilyNames() {
+		if family == "UnregisterTestFamily" {
+			t.Error("EraFamilyNames() should not include the removed era's family")
+		}
+	}
+
+	if UnregisterEra("UnregisterTestEra") {
+		t.Error("UnregisterEra() = true for an already removed era, want false")
+	}
+	if UnregisterEra("NoSuchEra") {
+		t.Error("UnregisterEra() = true for an unknown era, want false")
+	}
+
+	// Built-in eras cannot be removed
+	for _, name := range []string{"CE", "BE"} {
+		if UnregisterEra(name) {
+			t.Errorf("UnregisterEra(%q) = true, want false", name)
+		}
+		if GetEra(name) == nil {
+			t.Errorf("GetEra(%q) = nil after refused UnregisterEra", name)
+		}
+	}
+
+	// The name can be registered again
+	if again := RegisterEra("UnregisterTestEra", 200); again == nil || again.Offset() != 200 {
+		t.Errorf("RegisterEra() after UnregisterEra() = %v, want new era with offset 200", again)
+	}
+	UnregisterEra("UnregisterTestEra")
+}
+
+// TestUpdateEraWithOptions tests replacing a registered era's settings
+func TestUpdateEraWithOptions(t *testing.T) {
+	era := RegisterEraWithOptions(EraOptions{
+		Name:   "UpdateTestEra",
+		Offset: -2018,
+		Format: &EraFormat{Prefix: "Typo"},
+	})
+	defer UnregisterEra("UpdateTestEra")
+	if err := RegisterEraTransition("UpdateTestFamily", era, stdtime.Date(2019, 5, 1, 0, 0, 0, 0, stdtime.UTC)); err != nil {
+		t.Fatalf("RegisterEraTransition() unexpected error: %v", err)
+	}
+	SetLocaleDefaultEra("xx-UPDATE", era)
+
+	tm := Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(era)
+	if got := tm.FormatLocale("ja-JP", "{era} 2006"); got != "UpdateTestEra 0006" {
+		t.Fatalf("FormatLocale() before update = %q, want %q", got, "UpdateTestEra 0006")
+	}
+
+	updated, err := UpdateEraWithOptions(EraOptions{
+		Name:   "UpdateTestEra",
+		Offset: -2018,
+		Format: &EraFormat{Prefix: "令和"},
+		Names:  map[string]string{"ja-JP": "令和"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateEraWithOptions() unexpected error: %v", err)
+	}
+	if updated == era {
+		t.Fatal("UpdateEraWithOptions() should not modify the registered era")
+	}
+	if GetEra("UpdateTestEra") != updated {
+		t.Error("GetEra() after update should return the updated era")
+	}
+	if got := GetEraForDate(tm.Time, "UpdateTestFamily"); got != updated {
+		t.Errorf("GetEraForDate() after update = %p, want the updated era", got)
+	}
+	if got := DetectEraForLocale("xx-UPDATE"); got != updated {
+		t.Errorf("DetectEraForLocale() after update = %p, want the updated era", got)
+	}
+
+	// Existing Time values keep the era they hold
+	if got := tm.Era().Format().Prefix; got != "Typo" {
+		t.Errorf("Era().Format().Prefix of existing time = %q, want %q", got, "Typo")
+	}
+	if got := tm.InEra(GetEra("UpdateTestEra")).FormatLocale("ja-JP", "{era} 2006"); got != "令和 0006" {
+		t.Errorf("FormatLocale() after update = %q, want %q", got, "令和 0006")
+	}
+	if got := updated.Family(); got != DefaultEraFamily {
+		t.Errorf("Family() after update = %q, want %q", got, DefaultEraFamily)
+	}
+
+	// Offset changes are reflected despite the era cache
+	if _, err := UpdateEraWithOptions(EraOptions{Name: "UpdateTestEra", Offset: -2000}); err != nil {
+		t.Fatalf("UpdateEraWithOptions() unexpected error: %v", err)
+	}
+	if got := tm.InEra(GetEra("UpdateTestEra")).Year(); got != 24 {
+		t.Errorf("Year() after offset update = %d, want 24", got)
+	}
+
+	errorCases := []string{"", "CE", "BE", "NoSuchUpdateEra"}
+	for _, name := range errorCases {
+		if _, err := UpdateEraWithOptions(EraOptions{Name: name}); !IsValidationError(err) {
+			t.Errorf("UpdateEraWithOptions(%q) error = %v, want ValidationError", name, err)
+		}
+	}
+}
+
+// TestUpdateEraWithOptionsConcurrentFormat tests updating an era while
+// other goroutines format times in it, under the race detector
+func TestUpdateEraWithOptionsConcurrentFormat(t *testing.T) {
+	era := RegisterEraWithOptions(EraOptions{Name: "UpdateRaceEra", Offset: -2018})
+	defer UnregisterEra("UpdateRaceEra")
+	tm := Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(era)
+
+	stop := make(chan struct{})
+	var wg, started sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				_ = tm.FormatWithEraStyle("ja-JP", "2006-01-02")
+				_ = tm.Year()
+			}
+		}()
+	}
+	started.Wait()
+	for i := 0; i < 50; i++ {
+		if _, err := UpdateEraWithOptions(EraOptions{Name: "UpdateRaceEra", Offset: -2018 + i%2}); err != nil {
+			t.Fatalf("UpdateEraWithOptions() unexpected error: %v", err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+// TestROCEra tests the built-in Minguo (ROC) era.
+func TestROCEra(t *testing.T) {
+	if got := ROC().FromCE(2024); got != 113 {
+		t.Errorf("ROC().FromCE(2024) = %d, want 113", got)
+	}
+	if got := ROC().ToCE(1); got != 1912 {
+		t.Errorf("ROC().ToCE(1) = %d, want 1912", got)
+	}
+	if GetEra("ROC") != ROC() {
+		t.Error("GetEra(ROC) should return the built-in ROC era")
+	}
+	if ROC().IsValidYear(0) {
+		t.Error("ROC().IsValidYear(0) = true, want false")
+	}
+	if !ROC().IsValidYear(1) {
+		t.Error("ROC().IsValidYear(1) = false, want true")
+	}
+	if UnregisterEra("ROC") {
+		t.Error("UnregisterEra(ROC) = true, want false for built-in era")
+	}
+
+	parsed, err := ParseWithEra("2006/01/02", "113/06/15", ROC())
+	if err != nil {
+		t.Fatalf("ParseWithEra(ROC) unexpected error: %v", err)
+	}
+	if parsed.Time.Year() != 2024 || parsed.Month() != stdtime.June || parsed.Day() != 15 {
+		t.Errorf("ParseWithEra(ROC) = %v, want 2024-06-15", parsed.Time)
+	}
+	if parsed.Era() != ROC() {
+		t.Errorf("ParseWithEra(ROC).Era() = %v, want ROC", parsed.Era())
+	}
+
+	if got := parsed.FormatWithEraStyle("zh-TW", "2006"); got != "民國113年" {
+		t.Errorf("FormatWithEraStyle(zh-TW) = %q, want %q", got, "民國113年")
+	}
+	if got := parsed.FormatEra("en-US"); got != "ROC" {
+		t.Errorf("FormatEra(en-US) = %q, want %q", got, "ROC")
+	}
+}
+
+// TestParseWithEraROCRoundTrip tests that ROC times formatted with the
+// standard library layouts parse back to the same instant
+func TestParseWithEraROCRoundTrip(t *testing.T) {
+	taipei := stdtime.FixedZone("CST", 8*60*60)
+	tm := Date(2024, 3, 5, 10, 4, 5, 500000000, taipei).InEra(ROC())
+
+	layouts := []string{
+		stdtime.Layout, stdtime.ANSIC, stdtime.UnixDate, stdtime.RubyDate,
+		stdtime.RFC822, stdtime.RFC822Z, stdtime.RFC850, stdtime.RFC1123,
+		stdtime.RFC1123Z, stdtime.RFC3339, stdtime.RFC3339Nano,
+		"2006-01-02 15:04:05", "2006-01-02",
+		"2006-01-02T15:04:05.000Z0700", "2006-01-02T15:04:05.000000Z07:00",
+		"2006-01-02T15:04:05,000Z07:00", "15:04:05.999999999 2006-01-02 -0700",
+	}
+
+	for _, layout := range layouts {
+		t.Run(layout, func(t *testing.T) {
+			formatted := tm.Format(layout)
+			parsed, err := ParseWithEra(layout, formatted, ROC())
+			if err != nil {
+				t.Fatalf("ParseWithEra(%q) unexpected error: %v", formatted, err)
+			}
+			// The same layout in CE gives the expected instant
+			expected, err := stdtime.Parse(layout, tm.Time.Format(layout))
+			if err != nil {
+				t.Fatalf("time.Parse(%q) unexpected error: %v", tm.Time.Format(layout), err)
+			}
+			if !parsed.Time.Equal(expected) || parsed.Era() != ROC() {
+				t.Errorf("ParseWithEra(%q) = %v in %v, want %v in ROC", formatted, parsed.Time, parsed.Era(), expected)
+			}
+		})
+	}
+
+	// Unpadded era years with a fraction
+	parsed, err := ParseWithEra(stdtime.RFC3339Nano, "113-03-05T10:00:00.5+08:00", ROC())
+	if err != nil || !parsed.Time.Equal(stdtime.Date(2024, 3, 5, 10, 0, 0, 500000000, taipei)) {
+		t.Errorf("ParseWithEra(RFC3339Nano, unpadded) = %v, %v; want 2024-03-05T10:00:00.5+08:00", parsed.Time, err)
+	}
+
+	// A value that does not match the layout is an error, not a CE year
+	// labelled ROC
+	for _, value := range []string{"0113-03-05 10:00", "113/03/05"} {
+		if _, err := ParseWithEra("2006-01-02", value, ROC()); !IsParseError(err) {
+			t.Errorf("ParseWithEra(%q) error = %v, want ParseError", value, err)
+		}
+	}
+}
+
+// TestRegisterJapaneseEras tests the built-in Japanese era family.
+func TestRegisterJapaneseEras(t *testing.T) {
+	RegisterJapaneseEras()
+	RegisterJapaneseEras()
+
+	if got := len(GetEraTransitions("Japanese")); got != 5 {
+		t.Fatalf("len(GetEraTransitions(Japanese)) = %d, want 5", got)
+	}
+
+	tests := []struct {
+		date      stdtime.Time
+		era       string
+		formatted string
+	}{
+		{stdtime.Date(1868, 10, 23, 0, 0, 0, 0, jstZone), "Meiji", "明治元年"},
+		{stdtime.Date(1912, 7, 29, 23, 59, 59, 0, jstZone), "Meiji", "明治45年"},
+		{stdtime.Date(1912, 7, 30, 0, 0, 0, 0, jstZone), "Taisho", "大正元年"},
+		{stdtime.Date(1926, 12, 24, 23, 59, 59, 0, jstZone), "Taisho", "大正15年"},
+		{stdtime.Date(1926, 12, 25, 0, 0, 0, 0, jstZone), "Showa", "昭和元年"},
+		{stdtime.Date(1989, 1, 7, 23, 59, 59, 0, jstZone), "Showa", "昭和64年"},
+		{stdtime.Date(1989, 1, 8, 0, 0, 0, 0, jstZone), "Heisei", "平成元年"},
+		{stdtime.Date(2019, 4, 30, 23, 59, 59, 0, jstZone), "Heisei", "平成31年"},
+		{stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone), "Reiwa", "令和元年"},
+		{stdtime.Date(2024, 6, 15, 0, 0, 0, 0, jstZone), "Reiwa", "令和6年"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.formatted, func(t *testing.T) {
+			era := GetEraForDate(tt.date, "Japanese")
+			if era == nil || era.String() != tt.era {
+				t.Fatalf("GetEraForDate(%v, Japanese) = %v, want %s", tt.date, era, tt.era)
+			}
+			if got := FromStd(tt.date).InEra(era).FormatWithEraStyle("ja-JP", "2006"); got != tt.formatted {
+				t.Errorf("FormatWithEraStyle(ja-JP) = %q, want %q", got, tt.formatted)
+			}
+		})
+	}
+}
+
+// TestRegisterJapaneseErasAfterRestore tests registering the Japanese eras
+// again after RestoreEraRegistry removed them.
+func TestRegisterJapaneseErasAfterRestore(t *testing.T) {
+	defer RestoreEraRegistry(SnapshotEraRegistry())
+	for _, name := range []string{"Meiji", "Taisho", "Showa", "Heisei", "Reiwa"} {
+		UnregisterEra(name)
+	}
+
+	snapshot := SnapshotEraRegistry()
+	RegisterJapaneseEras()
+	RestoreEraRegistry(snapshot)
+	if GetEra("Reiwa") != nil {
+		t.Fatal("GetEra(Reiwa) after restore = non-nil, want nil")
+	}
+
+	RegisterJapaneseEras()
+	if GetEra("Reiwa") == nil {
+		t.Fatal("GetEra(Reiwa) after registering again = nil")
+	}
+	if got := len(GetEraTransitions("Japanese")); got != 5 {
+		t.Errorf("len(GetEraTransitions(Japanese)) = %d, want 5", got)
+	}
+
+	RestoreEraRegistry(snapshot)
+	if err := LoadRegion(RegionJapan); err != nil {
+		t.Fatalf("LoadRegion(JP) unexpected error: %v", err)
+	}
+	date := stdtime.Date(2024, 6, 15, 0, 0, 0, 0, jstZone)
+	if era := GetEraForDate(date, "Japanese"); era == nil || era != GetEra("Reiwa") {
+		t.Errorf("GetEraForDate(%v, Japanese) after LoadRegion(JP) = %v, want Reiwa", date, era)
+	}
+}
+
+// TestYearForDateInFamily tests era-local year resolution via transitions.
+func TestYearForDateInFamily(t *testing.T) {
+	RegisterJapaneseEras()
+
+	tests := []struct {
+		name     string
+		date     stdtime.Time
+		family   string
+		wantEra  string
+		wantYear int
+		wantOK   bool
+	}{
+		{"Reiwa 6", stdtime.Date(2024, 6, 15, 0, 0, 0, 0, jstZone), "Japanese", "Reiwa", 6, true},
+		{"Reiwa first day", stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone), "Japanese", "Reiwa", 1, true},
+		{"Heisei last day", stdtime.Date(2019, 4, 30, 0, 0, 0, 0, jstZone), "Japanese", "Heisei", 31, true},
+		{"Showa in UTC", stdtime.Date(1989, 1, 7, 12, 0, 0, 0, stdtime.UTC), "Japanese", "Showa", 64, true},
+		{"before first transition", stdtime.Date(1800, 1, 1, 0, 0, 0, 0, jstZone), "Japanese", "", 0, false},
+		{"unknown family", stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC), "NoSuchFamily", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			era, year, ok := YearForDateInFamily(tt.date, tt.family)
+			if ok != tt.wantOK {
+				t.Fatalf("YearForDateInFamily() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				if era != nil || year != 0 {
+					t.Errorf("YearForDateInFamily() = (%v, %d), want (nil, 0)", era, year)
+				}
+				return
+			}
+			if era.String() != tt.wantEra || year != tt.wantYear {
+				t.Errorf("YearForDateInFamily() = (%v, %d), want (%s, %d)", era, year, tt.wantEra, tt.wantYear)
+			}
+		})
+	}
+}
+
+// TestFormatWithEraStyleChecked tests that dates outside an era's range are rejected.
+func TestFormatWithEraStyleChecked(t *testing.T) {
+	RegisterJapaneseEras()
+	reiwa, heisei := GetEra("Reiwa"), GetEra("Heisei")
+
+	tests := []struct {
+		name         string
+		tm           Time
+		expected     string
+		wantErr      bool
+		wantExpected *Era
+	}{
+		{"within era", FromStd(stdtime.Date(2024, 6, 15, 0, 0, 0, 0, jstZone)).InEra(reiwa), "令和6年", false, nil},
+		{"first day of era", FromStd(stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "令和元年", false, nil},
+		{"before start date", FromStd(stdtime.Date(1850, 1, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "", true, nil},
+		{"day before start date", FromStd(stdtime.Date(2019, 4, 30, 0, 0, 0, 0, jstZone)).InEra(reiwa), "", true, heisei},
+		{"on end date", FromStd(stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone)).InEra(heisei), "", true, reiwa},
+		{"after end date", FromStd(stdtime.Date(2024, 6, 15, 0, 0, 0, 0, jstZone)).InEra(heisei), "", true, reiwa},
+		{"era without date range", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "2567", false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tm.FormatWithEraStyleChecked("ja-JP", "2006")
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("FormatWithEraStyleChecked() unexpected error: %v", err)
+				}
+				if got != tt.expected {
+					t.Errorf("FormatWithEraStyleChecked() = %q, want %q", got, tt.expected)
+				}
+				return
+			}
+
+			var eme *EraMismatchError
+			if !errors.As(err, &eme) {
+				t.Fatalf("FormatWithEraStyleChecked() error = %v, want EraMismatchError", err)
+			}
+			if eme.Code() != ErrCodeEraMismatch {
+				t.Errorf("Code() = %v, want %v", eme.Code(), ErrCodeEraMismatch)
+			}
+			if eme.ActualEra != tt.tm.Era() || eme.ExpectedEra != tt.wantExpected {
+				t.Errorf("EraMismatchError = (expected %v, actual %v), want (expected %v, actual %v)",
+					eme.ExpectedEra, eme.ActualEra, tt.wantExpected, tt.tm.Era())
+			}
+			if got != "" {
+				t.Errorf("FormatWithEraStyleChecked() = %q, want empty string on error", got)
+			}
+		})
+	}
+}
+
+// TestBuiltinEraNames tests the localized names of the built-in BE and CE eras.
+func TestBuiltinEraNames(t *testing.T) {
+	tests := []struct {
+		era      *Era
+		locale   string
+		expected string
+	}{
+		{BE(), "th-TH", "พ.ศ."},
+		{BE(), "en-US", "BE"},
+		{CE(), "th-TH", "ค.ศ."},
+		{CE(), "en-US", "CE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.era.String()+"/"+tt.locale, func(t *testing.T) {
+			if got := tt.era.NameForLocale(tt.locale); got != tt.expected {
+				t.Errorf("NameForLocale(%q) = %q, want %q", tt.locale, got, tt.expected)
+			}
+		})
+	}
+
+	// Localized names do not change the era identifiers
+	if BE().String() != "BE" || CE().String() != "CE" {
+		t.Errorf("String() = %q, %q, want BE, CE", BE().String(), CE().String())
+	}
+
+	beTime := Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	if got := beTime.FormatEra("th-TH"); got != "พ.ศ." {
+		t.Errorf("FormatEra(th-TH) = %q, want %q", got, "พ.ศ.")
+	}
+	if got := beTime.EraAbbrev("th-TH"); got != "พ.ศ." {
+		t.Errorf("EraAbbrev(th-TH) = %q, want %q", got, "พ.ศ.")
+	}
+}
+
+// TestSetEraCacheSize tests resizing the global era cache
+func TestSetEraCacheSize(t *testing.T) {
+	defer SetEraCacheSize(0)
+
+	SetEraCacheSize(4)
+	if got := EraCacheSize(); got != 4 {
+		t.Fatalf("EraCacheSize() = %d, want 4", got)
+	}
+	if stats := EraCacheStats(); stats != (internal.CacheStats{}) {
+		t.Errorf("EraCacheStats() after resize = %+v, want zero", stats)
+	}
+
+	for year := 2000; year < 2020; year++ {
+		_ = Date(year, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE()).Year()
+	}
+	if evictions := EraCacheStats().Evictions; evictions < 16 {
+		t.Errorf("EraCacheStats().Evictions = %d, want at least 16", evictions)
+	}
+
+	SetEraCacheSize(-1)
+	if got := EraCacheSize(); got != internal.DefaultMaxCacheSize {
+		t.Errorf("EraCacheSize() after SetEraCacheSize(-1) = %d, want %d", got, internal.DefaultMaxCacheSize)
+	}
+}
+
+// TestSetEraCacheTTL tests enabling expiry on the global era cache
+func TestSetEraCacheTTL(t *testing.T) {
+	defer SetEraCacheSize(0)
+	defer SetEraCacheTTL(0)
+
+	SetEraCacheSize(16)
+	SetEraCacheTTL(stdtime.Hour)
+	if got := eraCache().TTL(); got != stdtime.Hour {
+		t.Errorf("TTL after SetEraCacheTTL = %v, want 1h", got)
+	}
+	if got := EraCacheSize(); got != 16 {
+		t.Errorf("EraCacheSize() after SetEraCacheTTL = %d, want 16", got)
+	}
+
+	// Resizing keeps the TTL
+	SetEraCacheSize(32)
+	if got := eraCache().TTL(); got != stdtime.Hour {
+		t.Errorf("TTL after SetEraCacheSize = %v, want 1h", got)
+	}
+
+	tm := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	if tm.Year() != 2567 || tm.Year() != 2567 {
+		t.Fatalf("Year() = %d, want 2567", tm.Year())
+	}
+	if hits := EraCacheStats().Hits; hits == 0 {
+		t.Error("EraCacheStats().Hits = 0, want cache hits within TTL")
+	}
+}
+
+// TestDetectEraFromYearWithHint tests era detection with a plausible CE window
+func TestDetectEraFromYearWithHint(t *testing.T) {
+	SetEraDetectionReferenceDate(stdtime.Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC))
+	defer SetEraDetectionReferenceDate(stdtime.Time{})
+
+	tests := []struct {
+		name     string
+		year     int
+		min, max int
+		expected *Era
+	}{
+		{"Overlap year inside window", 2480, 1800, 2600, CE()},
+		{"Overlap year outside window", 2480, 1800, 2100, BE()},
+		{"Window upper bound inclusive", 2600, 1800, 2600, CE()},
+		{"Just above window", 2601, 1800, 2600, BE()},
+		{"Window lower bound inclusive", 2400, 2400, 2500, CE()},
+		{"Just below window falls back to proximity", 2399, 2400, 2500, BE()},
+		{"Current BE year outside window", 2567, 1900, 2100, BE()},
+		{"Current CE year outside window", 2024, 2400, 2600, CE()},
+		{"Empty window", 2480, 2600, 1800, BE()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectEraFromYearWithHint(tt.year, tt.min, tt.max); got != tt.expected {
+				t.Errorf("DetectEraFromYearWithHint(%d, %d, %d) = %v, want %v",
+					tt.year, tt.min, tt.max, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSetEraDetectionWindow tests window-based era detection
+func TestSetEraDetectionWindow(t *testing.T) {
+	// A reference date where proximity alone would misclassify 2024 and 2567
+	SetEraDetectionReferenceDate(stdtime.Date(2300, 1, 1, 0, 0, 0, 0, stdtime.UTC))
+	defer SetEraDetectionReferenceDate(stdtime.Time{})
+	// 2300 CE is 2843 BE, so 2567 is nearer 2300 and detected as CE
+	if got := DetectEraFromYear(2567); got != CE() {
+		t.Fatalf("DetectEraFromYear(2567) without window = %v, want CE", got)
+	}
+
+	SetEraDetectionWindow(1900, 2100)
+	defer ClearEraDetectionWindow()
+
+	tests := []struct {
+		year     int
+		expected *Era
+	}{
+		{2024, CE()},
+		{1900, CE()},
+		{2100, CE()},
+		{2567, BE()},
+		{2443, BE()}, // 1900 CE
+		{2643, BE()}, // 2100 CE
+		{2700, BE()}, // outside both; proximity to 2843 BE
+		{1500, CE()}, // outside both; proximity to 2300 CE
+	}
+	for _, tt := range tests {
+		if got := DetectEraFromYear(tt.year); got != tt.expected {
+			t.Errorf("DetectEraFromYear(%d) with window = %v, want %v", tt.year, got, tt.expected)
+		}
+	}
+
+	ClearEraDetectionWindow()
+	if got := DetectEraFromYear(2567); got != CE() {
+		t.Errorf("DetectEraFromYear(2567) after clearing = %v, want CE", got)
+	}
+}
+
+// TestDetectEraFromYearAndDate tests that detection is relative to the
+// supplied date
+func TestDetectEraFromYearAndDate(t *testing.T) {
+	SetEraDetectionReferenceDate(stdtime.Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC))
+	defer SetEraDetectionReferenceDate(stdtime.Time{})
+
+	tests := []struct {
+		name     string
+		year     int
+		date     stdtime.Time
+		expected *Era
+	}{
+		{"Record from 2090 CE", 2100, stdtime.Date(2090, 1, 1, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Record from 1550 CE", 2100, stdtime.Date(1550, 1, 1, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"Record from 1700 CE", 2250, stdtime.Date(1700, 1, 1, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"Record from 2024 CE", 2250, stdtime.Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Zero date uses reference date", 2100, stdtime.Time{}, CE()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectEraFromYearAndDate(tt.year, tt.date, "xx-NONE"); got != tt.expected {
+				t.Errorf("DetectEraFromYearAndDate(%d, %v) = %v, want %v", tt.year, tt.date, got, tt.expected)
+			}
+		})
+	}
+
+	// The locale default takes precedence over the date
+	SetLocaleDefaultEra("xx-DATE", BE())
+	defer ClearLocaleDefaultEra("xx-DATE")
+	if got := DetectEraFromYearAndDate(2100, stdtime.Date(2090, 1, 1, 0, 0, 0, 0, stdtime.UTC), "xx-DATE"); got != BE() {
+		t.Errorf("DetectEraFromYearAndDate() with locale default = %v, want BE", got)
+	}
+}
+
+// TestEraCacheStatsByEra tests that cache statistics are partitioned by era
+func TestEraCacheStatsByEra(t *testing.T) {
+	eraA := RegisterEra("CacheStatsA", 100)
+	eraB := RegisterEra("CacheStatsB", 200)
+	defer UnregisterEra("CacheStatsA")
+	defer UnregisterEra("CacheStatsB")
+	ClearEraCache()
+
+	tm := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+	for i := 0; i < 3; i++ {
+		_ = tm.InEra(eraA).Year()
+	}
+	_ = tm.InEra(eraB).Year()
+
+	stats := EraCacheStatsByEra()
+	if got := stats["CacheStatsA"]; got.Misses != 1 || got.Hits != 2 {
+		t.Errorf("stats[CacheStatsA] = %+v, want 1 miss and 2 hits", got)
+	}
+	if got := stats["CacheStatsB"]; got.Misses != 1 || got.Hits != 0 {
+		t.Errorf("stats[CacheStatsB] = %+v, want 1 miss and 0 hits", got)
+	}
+
+	ClearEraCache()
+	if stats := EraCacheStatsByEra(); len(stats) != 0 {
+		t.Errorf("EraCacheStatsByEra() after clear = %v, want empty", stats)
+	}
+}
+
+// TestFormatWithEraStyleGannen tests that the first era year is rendered as 元.
+func TestFormatWithEraStyleGannen(t *testing.T) {
+	RegisterJapaneseEras()
+	reiwa := GetEra("Reiwa")
+
+	zeroBased := RegisterEraWithOptions(EraOptions{
+		Name:   "TestZeroBasedGannen",
+		Offset: -2018,
+		Format: &EraFormat{Prefix: "Z", Suffix: "年", Gannen: true, ZeroBased: true},
+	})
+	defer UnregisterEra("TestZeroBasedGannen")
+
+	tests := []struct {
+		name     string
+		tm       Time
+		layout   string
+		expected string
+	}{
+		{"first year", FromStd(stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006", "令和元年"},
+		{"first year with date", FromStd(stdtime.Date(2019, 12, 31, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006 01/02", "令和元年 12/31"},
+		{"first year short layout", FromStd(stdtime.Date(2019, 5, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "06/01/02", "元/05/01"},
+		{"second year", FromStd(stdtime.Date(2020, 1, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006", "令和2年"},
+		{"second year short layout", FromStd(stdtime.Date(2020, 1, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "06/01/02", "02/01/01"},
+		{"zero-based first year", FromStd(stdtime.Date(2019, 6, 1, 0, 0, 0, 0, jstZone)).InEra(zeroBased), "2006", "Z元年"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatWithEraStyle("ja-JP", tt.layout); got != tt.expected {
+				t.Errorf("FormatWithEraStyle(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatWithEraStyleFullDate tests that a layout writing 年 after the
+// year does not repeat the era suffix.
+func TestFormatWithEraStyleFullDate(t *testing.T) {
+	RegisterJapaneseEras()
+	reiwa := GetEra("Reiwa")
+
+	tests := []struct {
+		name     string
+		tm       Time
+		layout   string
+		expected string
+	}{
+		{"Reiwa", FromStd(stdtime.Date(2024, 3, 5, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006年01月02日", "令和6年03月05日"},
+		{"Reiwa first year", FromStd(stdtime.Date(2019, 6, 1, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006年01月02日", "令和元年06月01日"},
+		{"Reiwa unpadded", FromStd(stdtime.Date(2024, 3, 5, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006年1月2日", "令和6年3月5日"},
+		{"Reiwa without 年", FromStd(stdtime.Date(2024, 3, 5, 0, 0, 0, 0, jstZone)).InEra(reiwa), "2006/01/02", "令和6年/03/05"},
+		{"ROC", Date(2024, 3, 5, 0, 0, 0, 0, stdtime.UTC).InEra(ROC()), "2006年01月02日", "民國113年03月05日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatWithEraStyle("ja-JP", tt.layout); got != tt.expected {
+				t.Errorf("FormatWithEraStyle(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatWithEraStyleYearDigits tests that YearDigits pads the era year
+// between the era prefix and suffix.
+func TestFormatWithEraStyleYearDigits(t *testing.T) {
+	twoDigits := RegisterEraWithOptions(EraOptions{
+		Name:   "TestYearDigitsTwo",
+		Offset: -2018,
+		Format: &EraFormat{Prefix: "令和", YearDigits: 2},
+	})
+	defer UnregisterEra("TestYearDigitsTwo")
+
+	fourDigits := RegisterEraWithOptions(EraOptions{
+		Name:   "TestYearDigitsFour",
+		Offset: -2018,
+		Format: &EraFormat{Prefix: "R", Suffix: "Y", YearDigits: 4},
+	})
+	defer UnregisterEra("TestYearDigitsFour")
+
+	date := stdtime.Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		era      *Era
+		layout   string
+		expected string
+	}{
+		{"two digits", twoDigits, "2006", "令和06"},
+		{"two digits with date", twoDigits, "2006-01-02", "令和06-06-15"},
+		{"four digits", fourDigits, "2006", "R0006Y"},
+		{"four digits with date", fourDigits, "02/01/2006", "15/06/R0006Y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromStd(date).InEra(tt.era).FormatWithEraStyle("ja-JP", tt.layout); got != tt.expected {
+				t.Errorf("FormatWithEraStyle(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ package time
 import (
 	"errors"
 	"fmt"
+	"strings"
+	stdtime "time"
 )
 
 // ErrorCode represents a category of errors for programmatic handling.
@@ -75,7 +77,7 @@ type ParseError struct {
 	Input    string
 	Layout   string
 	Era      *Era
-	Position int // Line number where the error occurred (1-based)
+	Position int // 1-based byte offset in Input where parsing failed; 0 if unknown
 }
 
 // newParseError creates a new ParseError with the specified parameters.
@@ -107,13 +109,88 @@ func newParseError(input, layout string, era *Era, pos int, original error) *Par
 // Line returns the 1-based line number where the error occurred.
 // Returns 0 if position information is not available.
 func (e *ParseError) Line() int {
-	return e.Position
+	if e.Position <= 0 {
+		return 0
+	}
+	return 1 + strings.Count(e.Input[:e.errorOffset()], "\n")
 }
 
-// Column returns the column position where the error occurred.
-// Currently returns the same value as Line() for compatibility.
+// Column returns the 1-based byte column, within its line, where the error
+// occurred. Returns 0 if position information is not available.
 func (e *ParseError) Column() int {
-	return e.Position
+	if e.Position <= 0 {
+		return 0
+	}
+	offset := e.errorOffset()
+	return offset - strings.LastIndexByte(e.Input[:offset], '\n')
+}
+
+// errorOffset returns the 0-based byte offset of Position, clamped to Input.
+func (e *ParseError) errorOffset() int {
+	if e.Position > len(e.Input) {
+		return len(e.Input)
+	}
+	return e.Position - 1
+}
+
+// parseErrorPosition returns the 1-based byte offset in input of the field
+// that caused err, an error returned by the standard library when parsing
+// parsed, which is input after name and year conversion. It returns 0 if
+// the offset is unknown, including when the conversion changed the length
+// so that offsets in parsed do not apply to input.
+//
+// For a value that does not match the layout the offset is where matching
+// stopped; for an out of range field it is the start of that field.
+func parseErrorPosition(input, parsed string, err error) int {
+	var pe *stdtime.ParseError
+	if len(parsed) != len(input) || !errors.As(err, &pe) {
+		return 0
+	}
+
+	// ValueElem is the unparsed rest of the value
+	offset := len(pe.Value) - len(pe.ValueElem)
+
+	if strings.HasSuffix(pe.Message, "out of range") {
+		if pe.LayoutElem == "" && strings.Contains(pe.Message, "day") {
+			// The day is checked against the month after the whole value
+			// is parsed, so locate the day field from the layout
+			offset = dayFieldEnd(pe.Layout, pe.Value)
+		}
+		// Range errors are reported after the numeric field is consumed
+		for offset > 0 && pe.Value[offset-1] >= '0' && pe.Value[offset-1] <= '9' {
+			offset--
+		}
+	}
+
+	return offset + 1
+}
+
+// dayFieldEnd returns the byte offset in value just past the field matched
+// by the first day-of-month element of layout, or len(value) if layout has
+// no such element.
+func dayFieldEnd(layout, value string) int {
+	end := 0
+	rest := layout
+	for {
+		prefix, elem, suffix := nextLayoutElement(rest)
+		if elem == "" {
+			return len(value)
+		}
+		end += len(prefix) + len(elem)
+		if elem == "2" || elem == "02" || elem == "_2" {
+			break
+		}
+		rest = suffix
+	}
+
+	// Parsing the layout up to the day reports the rest of the value as
+	// extra text
+	_, err := stdtime.Parse(layout[:end], value)
+	var pe *stdtime.ParseError
+	if errors.As(err, &pe) && strings.HasPrefix(pe.Message, ": extra text") {
+		return len(value) - len(pe.ValueElem)
+	}
+	return len(value)
 }
 
 // Error returns a human-readable description of the parse error,
@@ -134,6 +211,23 @@ type ThaiTextError struct {
 	Reason string
 }
 
+// newThaiTextError creates a new ThaiTextError for input with the given
+// reason.
+func newThaiTextError(input, reason string) *ThaiTextError {
+	return &ThaiTextError{
+		baseError: baseError{
+			code:    ErrCodeThaiText,
+			message: "invalid Thai text",
+			context: map[string]any{
+				"input":  input,
+				"reason": reason,
+			},
+		},
+		Input:  input,
+		Reason: reason,
+	}
+}
+
 // Error returns a human-readable description of the Thai text error.
 func (e *ThaiTextError) Error() string {
 	return fmt.Sprintf("invalid Thai text %q: %s", e.Input, e.Reason)
@@ -147,6 +241,25 @@ type ValidationError struct {
 	Constraint string
 }
 
+// newValidationError creates a new ValidationError for the given field,
+// offending value, and violated constraint.
+func newValidationError(code ErrorCode, field string, value any, constraint string) *ValidationError {
+	return &ValidationError{
+		baseError: baseError{
+			code:    code,
+			message: "validation failed",
+			context: map[string]any{
+				"field":      field,
+				"value":      value,
+				"constraint": constraint,
+			},
+		},
+		Field:      field,
+		Value:      value,
+		Constraint: constraint,
+	}
+}
+
 // Error returns a human-readable description of the validation error.
 func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation failed for %s: %s (value=%v)", e.Field, e.Constraint, e.Value)
@@ -174,8 +287,31 @@ type EraMismatchError struct {
 	Details     string
 }
 
+// newEraMismatchError creates a new EraMismatchError for a time whose era
+// does not match the era expected for it.
+func newEraMismatchError(expected, actual *Era, details string) *EraMismatchError {
+	return &EraMismatchError{
+		baseError: baseError{
+			code:    ErrCodeEraMismatch,
+			message: "era mismatch",
+			context: map[string]any{
+				"expected_era": expected,
+				"actual_era":   actual,
+				"details":      details,
+			},
+		},
+		ExpectedEra: expected,
+		ActualEra:   actual,
+		Details:     details,
+	}
+}
+
 // Error returns a human-readable description of the era mismatch error.
 func (e *EraMismatchError) Error() string {
+	if e.ExpectedEra == nil && e.ActualEra == nil {
+		// Neither era is known, as when ParseThaiStrict cannot decide
+		return "era mismatch: " + e.Details
+	}
 	return fmt.Sprintf("era mismatch: expected %s, got %s: %s",
 		e.getEraName(e.ExpectedEra), e.getEraName(e.ActualEra), e.Details)
 }
@@ -247,6 +383,13 @@ func (e *MultiError) Range(f func(index int, err error)) {
 	}
 }
 
+// Unwrap returns the errors in the collection. It lets errors.Is and
+// errors.As (Go 1.20+) traverse the collection when the MultiError is
+// itself wrapped, and matches the form used by errors.Join.
+func (e *MultiError) Unwrap() []error {
+	return e.errors
+}
+
 // Is reports whether any error in the collection matches target.
 func (e *MultiError) Is(target error) bool {
 	for _, err := range e.errors {
@@ -0,0 +1,41 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestMultiErrorUnwrap tests that wrapped MultiErrors expose their children
+func TestMultiErrorUnwrap(t *testing.T) {
+	_, parseErr := ParseWithEra("2006-01-02", "2567-13-01", BE())
+	if parseErr == nil {
+		t.Fatal("ParseWithEra() expected error")
+	}
+	validationErr := newValidationError(ErrCodeOutOfBounds, "year", 0, "year must be positive")
+
+	multi := NewMultiError()
+	multi.AddAll(validationErr, parseErr)
+
+	if got := multi.Unwrap(); len(got) != 2 || got[0] != validationErr || got[1] != parseErr {
+		t.Errorf("Unwrap() = %v, want both errors in order", got)
+	}
+
+	wrapped := fmt.Errorf("import failed: %w", multi)
+	if !errors.Is(wrapped, parseErr) {
+		t.Error("errors.Is(wrapped, parseErr) = false, want true")
+	}
+
+	var pe *ParseError
+	if !errors.As(wrapped, &pe) {
+		t.Fatal("errors.As(wrapped, *ParseError) = false, want true")
+	}
+	if pe.Input != "2567-13-01" {
+		t.Errorf("ParseError.Input = %q, want %q", pe.Input, "2567-13-01")
+	}
+
+	var ve *ValidationError
+	if !errors.As(wrapped, &ve) {
+		t.Error("errors.As(wrapped, *ValidationError) = false, want true")
+	}
+}
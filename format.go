@@ -7,8 +7,9 @@
 // All formatting operations are thread-safe:
 //
 //   - FormatLocale() uses thread-safe global replacers and caches
+//   - The locale registry (RegisterLocale) is a concurrent map
 //   - StringReplacer instances are immutable after initialization
-//   - RegexPool uses sync.Pool for thread-safe regex reuse
+//   - RegexPool shares one compiled regex, which is safe for concurrent use
 //   - Reference date configuration uses sync.RWMutex
 //
 // The package uses pre-compiled string replacers and regex pools that are
@@ -18,7 +19,6 @@ package time
 import (
 	"strconv"
 	"strings"
-	"sync"
 	stdtime "time"
 	"unsafe"
 
@@ -31,20 +31,41 @@ const (
 	LocaleThTH = "th-TH"
 	// LocaleEnUS represents the English (United States) locale for formatting.
 	LocaleEnUS = "en-US"
+	// LocaleLoLA represents the Lao (Laos) locale for formatting.
+	LocaleLoLA = "lo-LA"
+	// LocaleKmKH represents the Khmer (Cambodia) locale for formatting.
+	LocaleKmKH = "km-KH"
+	// LocaleMyMM represents the Burmese (Myanmar) locale for formatting.
+	LocaleMyMM = "my-MM"
 	// LocaleDefault represents the default locale (no special formatting).
 	LocaleDefault = ""
 )
 
 // FormatLocale formats the time value according to the specified locale and layout.
-// For Thai locale (th-TH), it translates month and day names to Thai.
+// For registered locales (see RegisterLocale), such as th-TH and lo-LA, it
+// translates month and day names; other locales keep the English names.
 // It also adjusts the year to the appropriate era based on the time's era setting.
 // This method uses caching for era year calculations.
+//
+// The layout may contain the placeholder "{era}", which is replaced with the
+// era name localized for the locale (see FormatEra), e.g. "{era} 2006"
+// yields "พ.ศ. 2567" for a BE time in th-TH. For CE the placeholder is empty.
 func (t Time) FormatLocale(locale string, layout string) string {
+	if strings.Contains(layout, eraPlaceholder) {
+		return replaceEraPlaceholder(t.formatLocale(locale, layout), t.FormatEra(locale))
+	}
+	return t.formatLocale(locale, layout)
+}
+
+// formatLocale implements FormatLocale without "{era}" placeholder handling.
+func (t Time) formatLocale(locale string, layout string) string {
 	era := t.Era()
 	ceYear := t.Time.Year()
 
-	// Fast path for CE era with non-Thai locale: no special processing needed
-	if era == CE() && locale != LocaleThTH {
+	names, localized := lookupLocale(locale)
+
+	// Fast path for CE era with an unregistered locale: no special processing needed
+	if era == CE() && !localized {
 		return t.Time.Format(layout)
 	}
 
@@ -52,31 +73,26 @@ func (t Time) FormatLocale(locale string, layout string) string {
 	var eraYear int
 	if era != CE() {
 		//nolint:gosec
-		if cachedYear, ok := globalEraCache.Get(ceYear, unsafe.Pointer(era)); ok {
+		if cachedYear, ok := eraCache().Get(ceYear, unsafe.Pointer(era)); ok {
 			eraYear = cachedYear
 		} else {
 			eraYear = era.FromCE(ceYear)
 			//nolint:gosec
-			globalEraCache.Set(ceYear, unsafe.Pointer(era), eraYear)
-		}
-	}
-
-	if locale == LocaleThTH {
-		formatted := t.Time.Format(layout)
-		formatted = replaceThaiLocale(formatted)
-
-		if era != CE() {
-			formatted = replaceYearInFormatted(formatted, eraYear)
+			eraCache().Set(ceYear, unsafe.Pointer(era), eraYear)
 		}
-		return formatted
 	}
 
+	var formatted string
 	if era != CE() {
-		formatted := t.Time.Format(layout)
-		return replaceYearInFormatted(formatted, eraYear)
+		formatted = formatWithEraYear(t.Time, layout, eraYear)
+	} else {
+		formatted = t.Time.Format(layout)
 	}
 
-	return t.Time.Format(layout)
+	if localized {
+		return names.replacer.Replace(formatted)
+	}
+	return formatted
 }
 
 var (
@@ -90,27 +106,18 @@ var (
 	thaiMonthReplacer *internal.StringReplacer
 	thaiDayReplacer   *internal.StringReplacer
 
-	// Combined Thai replacer for single-pass month/day replacement in FormatLocale.
-	// This consolidates month and day replacements into one pass for better performance.
-	thaiLocaleReplacer *internal.StringReplacer
-
-	// yearFormatReferenceDate is the reference date for short year matching.
-	// If zero, time.Now().Year() is used. This enables deterministic testing.
-	yearFormatReferenceDate stdtime.Time
-	yearFormatMu            sync.RWMutex
-
 	// builderPool provides pooled strings.Builder instances for reduced allocations.
-	// Used in replaceYearInFormatted and other string construction operations.
+	// Used in formatWithYear and other string construction operations.
 	builderPool = internal.NewBuilderPool()
 )
 
-// SetYearFormatReferenceDate sets the reference date for short year matching in formatting.
-// This is useful for deterministic testing. Pass a zero time.Time to use time.Now().
-func SetYearFormatReferenceDate(t stdtime.Time) {
-	yearFormatMu.Lock()
-	defer yearFormatMu.Unlock()
-	yearFormatReferenceDate = t
-}
+// SetYearFormatReferenceDate previously set the reference date used to
+// recognize two-digit years in formatted output.
+//
+// Deprecated: Era years are now written at the positions of the layout's
+// "2006" and "06" elements, so no reference date is needed. This function
+// has no effect.
+func SetYearFormatReferenceDate(t stdtime.Time) {}
 
 func init() {
 	// Pre-compile all string replacers for optimal performance.
@@ -119,10 +126,6 @@ func init() {
 	dayReplacer = internal.NewStringReplacer(mergeDayMaps())
 	thaiMonthReplacer = internal.NewStringReplacer(mergeThaiToEnglishMonthMaps())
 	thaiDayReplacer = internal.NewStringReplacer(mergeThaiToEnglishDayMaps())
-
-	// Create combined Thai locale replacer for single-pass replacement
-	// This merges month and day maps for better performance in FormatLocale
-	thaiLocaleReplacer = internal.NewStringReplacer(mergeThaiLocaleMaps())
 }
 
 // mergeMaps combines multiple string maps into a single map.
@@ -185,12 +188,6 @@ func mergeThaiToEnglishDayMaps() map[string]string {
 	return mergeMaps(thaiToEnglishDayNames, thaiToEnglishShortDayNames)
 }
 
-// mergeThaiLocaleMaps combines month and day name maps for single-pass Thai locale replacement.
-// This is used by FormatLocale to replace both month and day names in one pass.
-func mergeThaiLocaleMaps() map[string]string {
-	return mergeMaps(monthNames, shortMonthNames, dayNames, shortDayNames)
-}
-
 var monthNames = map[string]string{
 	"January":   "มกราคม",
 	"February":  "กุมภาพันธ์",
@@ -309,6 +306,142 @@ func replaceThaiMonthNames(s string) string {
 	return thaiMonthReplacer.Replace(s)
 }
 
+// Characters handled by NormalizeThaiText.
+const (
+	zeroWidthSpace  = "\u200B"
+	thaiSaraAa      = '\u0E32'
+	thaiSaraAm      = '\u0E33'
+	thaiNikhahit    = '\u0E4D'
+	thaiFebruary    = "กุมภาพันธ์"
+	thaiFebruaryAlt = "กุมภาพันธ" // without the final thanthakhat
+)
+
+// NormalizeThaiText cleans up common variations in Thai text that would
+// otherwise prevent month and day names from being recognized:
+//
+//   - zero-width spaces (U+200B), used as word breaks in Thai, are removed
+//   - combining vowels and tone marks are put in canonical (NFC) order, so
+//     a tone mark typed before a below-vowel, as in "ปุ่", matches "ปุ่"
+//   - nikhahit followed by sara aa ("ํา") is replaced with sara am ("ำ")
+//   - "กุมภาพันธ" without its final thanthakhat becomes "กุมภาพันธ์"
+//
+// Text without Thai characters or zero-width spaces is returned unchanged.
+// ParseThai and its variants normalize their input with NormalizeThaiText.
+func NormalizeThaiText(s string) string {
+	if !strings.Contains(s, "\xe0\xb8") && !strings.Contains(s, "\xe0\xb9") {
+		return strings.ReplaceAll(s, zeroWidthSpace, "")
+	}
+
+	runes := []rune(strings.ReplaceAll(s, zeroWidthSpace, ""))
+	orderThaiMarks(runes)
+
+	sb := builderPool.Get(len(s))
+	defer builderPool.Put(sb)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == thaiNikhahit {
+			switch {
+			case i+1 < len(runes) && runes[i+1] == thaiSaraAa:
+				sb.WriteRune(thaiSaraAm)
+				i++
+				continue
+			case i+2 < len(runes) && isThaiToneMark(runes[i+1]) && runes[i+2] == thaiSaraAa:
+				sb.WriteRune(runes[i+1])
+				sb.WriteRune(thaiSaraAm)
+				i += 2
+				continue
+			}
+		}
+		sb.WriteRune(r)
+	}
+
+	normalized := sb.String()
+	if strings.Contains(normalized, thaiFebruaryAlt) {
+		normalized = strings.ReplaceAll(normalized, thaiFebruary, thaiFebruaryAlt)
+		normalized = strings.ReplaceAll(normalized, thaiFebruaryAlt, thaiFebruary)
+	}
+	return normalized
+}
+
+// NormalizeThaiTextStrict is like NormalizeThaiText but returns a
+// *ThaiTextError if the normalized text contains a clearly invalid sequence:
+// a combining vowel or tone mark that does not follow a Thai consonant, or
+// two tone marks on the same consonant.
+func NormalizeThaiTextStrict(s string) (string, error) {
+	normalized := NormalizeThaiText(s)
+
+	prev, tones := rune(0), 0
+	for i, r := range normalized {
+		switch {
+		case isThaiConsonant(r):
+			tones = 0
+		case isThaiCombiningMark(r):
+			if !isThaiConsonant(prev) && !isThaiCombiningMark(prev) {
+				return "", newThaiTextError(s, "combining mark "+strconv.QuoteRune(r)+
+					" without a consonant at byte "+strconv.Itoa(i))
+			}
+			if isThaiToneMark(r) {
+				tones++
+				if tones > 1 {
+					return "", newThaiTextError(s, "repeated tone mark "+strconv.QuoteRune(r)+
+						" at byte "+strconv.Itoa(i))
+				}
+			}
+		}
+		prev = r
+	}
+	return normalized, nil
+}
+
+// isThaiConsonant reports whether r is a Thai consonant (ก-ฮ).
+func isThaiConsonant(r rune) bool {
+	return '\u0E01' <= r && r <= '\u0E2E'
+}
+
+// isThaiToneMark reports whether r is one of the four Thai tone marks.
+func isThaiToneMark(r rune) bool {
+	return '\u0E48' <= r && r <= '\u0E4B'
+}
+
+// isThaiCombiningMark reports whether r is a Thai vowel or sign written
+// above or below a consonant.
+func isThaiCombiningMark(r rune) bool {
+	return r == '\u0E31' || ('\u0E34' <= r && r <= '\u0E3A') || ('\u0E47' <= r && r <= '\u0E4E')
+}
+
+// thaiCombiningClass returns the Unicode canonical combining class of r,
+// which is non-zero only for the Thai marks that NFC reorders.
+func thaiCombiningClass(r rune) int {
+	switch {
+	case r == '\u0E38' || r == '\u0E39': // sara u, sara uu
+		return 103
+	case r == '\u0E3A': // phinthu
+		return 9
+	case isThaiToneMark(r):
+		return 107
+	}
+	return 0
+}
+
+// orderThaiMarks sorts each run of Thai marks with non-zero combining class
+// by class, as in Unicode canonical ordering. The sort is stable.
+func orderThaiMarks(runes []rune) {
+	for i := 1; i < len(runes); i++ {
+		class := thaiCombiningClass(runes[i])
+		if class == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			prev := thaiCombiningClass(runes[j-1])
+			if prev == 0 || prev <= class {
+				break
+			}
+			runes[j-1], runes[j] = runes[j], runes[j-1]
+		}
+	}
+}
+
 // replaceThaiDayNames replaces all Thai day names with English names.
 // Uses pre-compiled StringReplacer for O(n) single-pass replacement.
 func replaceThaiDayNames(s string) string {
@@ -316,127 +449,397 @@ func replaceThaiDayNames(s string) string {
 }
 
 // replaceThaiLocale replaces all English month and day names with Thai names.
-// Uses pre-compiled combined StringReplacer for O(n) single-pass replacement.
+// Uses the registered th-TH replacer for O(n) single-pass replacement.
 func replaceThaiLocale(s string) string {
-	return thaiLocaleReplacer.Replace(s)
+	return replaceLocaleNames(LocaleThTH, s)
 }
 
-// isWordChar checks if a character is a word character.
-// Word characters include: letters (a-z, A-Z), digits (0-9), and underscore (_).
-func isWordChar(c byte) bool {
-	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+// Sentinel bytes that stand in for the "2006" and "06" layout elements.
+// time.Time.Format copies them to the output verbatim, so the era year can be
+// written at exactly the positions where the layout asked for a year.
+const (
+	longYearSentinel  = '\x00'
+	shortYearSentinel = '\x01'
+)
+
+// yearMarkedLayouts caches layouts rewritten by markYearLayout, keyed by
+// the original layout string.
+var yearMarkedLayouts = internal.NewLayoutCache(internal.DefaultMaxLayoutCacheSize)
+
+// markYearLayout returns layout with its "2006" and "06" elements replaced by
+// sentinel bytes. Elements are located with the same rules as
+// time.Time.Format, so digits in literal text or in other elements, such as
+// "1504" or ".0000", are never mistaken for a year. A layout that already
+// contains sentinel bytes is returned unchanged.
+func markYearLayout(layout string) string {
+	if cached, ok := yearMarkedLayouts.Load(layout); ok {
+		return cached.(string)
+	}
+
+	marked := layout
+	if strings.IndexByte(layout, longYearSentinel) < 0 && strings.IndexByte(layout, shortYearSentinel) < 0 {
+		var sb strings.Builder
+		sb.Grow(len(layout))
+
+		rest := layout
+		for rest != "" {
+			prefix, elem, suffix := nextLayoutElement(rest)
+			sb.WriteString(prefix)
+			switch elem {
+			case "2006":
+				sb.WriteByte(longYearSentinel)
+			case "06":
+				sb.WriteByte(shortYearSentinel)
+			default:
+				sb.WriteString(elem)
+			}
+			rest = suffix
+		}
+		marked = sb.String()
+	}
+
+	return yearMarkedLayouts.LoadOrStore(layout, marked).(string)
 }
 
-// isWordBoundaryBefore checks if there's a word boundary before the given position.
-// Returns true if at start of string or previous character is not a word character.
-func isWordBoundaryBefore(s string, pos int) bool {
-	return pos == 0 || !isWordChar(s[pos-1])
+// nextLayoutElement splits layout around its first layout element, following
+// the rules of the standard library's layout parser. It returns the literal
+// text before the element, the element itself, and the remaining layout.
+// If layout has no elements, elem and suffix are empty.
+func nextLayoutElement(layout string) (prefix, elem, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+		n := 0
+
+		switch layout[i] {
+		case 'J': // January, Jan
+			if strings.HasPrefix(rest, "January") {
+				n = 7
+			} else if strings.HasPrefix(rest, "Jan") && !startsWithLowerCase(rest[3:]) {
+				n = 3
+			}
+		case 'M': // Monday, Mon, MST
+			if strings.HasPrefix(rest, "Monday") {
+				n = 6
+			} else if strings.HasPrefix(rest, "Mon") && !startsWithLowerCase(rest[3:]) {
+				n = 3
+			} else if strings.HasPrefix(rest, "MST") {
+				n = 3
+			}
+		case '0': // 01, 02, 03, 04, 05, 06, 002
+			if len(rest) >= 2 && rest[1] >= '1' && rest[1] <= '6' {
+				n = 2
+			} else if strings.HasPrefix(rest, "002") {
+				n = 3
+			}
+		case '1': // 15, 1
+			n = 1
+			if strings.HasPrefix(rest, "15") {
+				n = 2
+			}
+		case '2': // 2006, 2
+			n = 1
+			if strings.HasPrefix(rest, "2006") {
+				n = 4
+			}
+		case '_': // _2, _2006, __2
+			if strings.HasPrefix(rest, "_2006") {
+				// A literal underscore followed by the year
+				return layout[:i+1], "2006", layout[i+5:]
+			}
+			if strings.HasPrefix(rest, "_2") {
+				n = 2
+			} else if strings.HasPrefix(rest, "__2") {
+				n = 3
+			}
+		case '3', '4', '5':
+			n = 1
+		case 'P': // PM
+			if strings.HasPrefix(rest, "PM") {
+				n = 2
+			}
+		case 'p': // pm
+			if strings.HasPrefix(rest, "pm") {
+				n = 2
+			}
+		case '-', 'Z': // -070000, -07:00:00, -0700, -07:00, -07 and Z variants
+			for _, zone := range [...]string{"070000", "07:00:00", "0700", "07:00", "07"} {
+				if strings.HasPrefix(rest[1:], zone) {
+					n = 1 + len(zone)
+					break
+				}
+			}
+		case '.', ',': // .000, .999, ,000, ,999 fractional seconds
+			if len(rest) >= 2 && (rest[1] == '0' || rest[1] == '9') {
+				j := 1
+				for j < len(rest) && rest[j] == rest[1] {
+					j++
+				}
+				// The run of digits must not be followed by another digit
+				if j == len(rest) || rest[j] < '0' || rest[j] > '9' {
+					n = j
+				}
+			}
+		}
+
+		if n > 0 {
+			return layout[:i], layout[i : i+n], layout[i+n:]
+		}
+	}
+	return layout, "", ""
 }
 
-// isWordBoundaryAfter checks if there's a word boundary after the given position.
-// Returns true if at end of string or next character is not a word character.
-func isWordBoundaryAfter(s string, pos int) bool {
-	return pos >= len(s) || !isWordChar(s[pos])
+// startsWithLowerCase reports whether s begins with a lower-case ASCII letter.
+func startsWithLowerCase(s string) bool {
+	return s != "" && s[0] >= 'a' && s[0] <= 'z'
 }
 
-// replaceYearInFormatted replaces year numbers in formatted output with era year.
-// Uses manual character-by-character parsing instead of regex for better performance.
-// This approach avoids regex allocations and provides O(n) single-pass replacement.
-//
-// Performance characteristics:
-//   - Time: O(n) single pass through input string
-//   - Space: O(n) for result, uses pooled builder
-//   - Allocations: 1 result string + 2 small year buffers (stack-allocated)
+// formatWithEraYear formats t with layout, rendering the layout's "2006"
+// element as the four-digit era year and "06" as its last two digits.
+// Other numbers in the output are left untouched.
 //
 // Year buffer optimization: Uses fixed-size byte arrays for small, known-size
 // year strings (4 digits for full year, 2 digits for short year). This avoids
 // heap allocations for the common case of year formatting.
-func replaceYearInFormatted(formatted string, eraYear int) string {
-	// Pre-compute year strings using strconv for efficiency
-	// Using fixed-size arrays to avoid heap allocations for small buffers
+func formatWithEraYear(t stdtime.Time, layout string, eraYear int) string {
 	var yearBuf [4]byte
-	yearStr := strconv.AppendInt(yearBuf[:0], int64(eraYear), 10)
-	// Pad to 4 digits with leading zeros
-	for len(yearStr) < 4 {
-		yearStr = append(yearStr, '0')
-	}
+	yearStr := appendPaddedInt(yearBuf[:0], eraYear, 4)
 
-	// Format short year (2 digits)
 	var shortYearBuf [2]byte
-	shortYearStr := strconv.AppendInt(shortYearBuf[:0], int64(eraYear%100), 10)
-	// Pad to 2 digits with leading zeros
-	for len(shortYearStr) < 2 {
-		shortYearStr = append(shortYearStr, '0')
-	}
+	shortYearStr := appendPaddedInt(shortYearBuf[:0], shortYear(eraYear), 2)
 
-	// Get reference year's last 2 digits
-	// Uses configurable reference date for deterministic testing
-	yearFormatMu.RLock()
-	refDate := yearFormatReferenceDate
-	yearFormatMu.RUnlock()
+	return formatWithYear(t, layout, yearStr, shortYearStr)
+}
 
-	if refDate.IsZero() {
-		refDate = stdtime.Now()
-	}
-	currentShortYear := strconv.Itoa(refDate.Year() % 100)
-	// Pad to 2 digits with leading zeros
-	if len(currentShortYear) == 1 {
-		currentShortYear = "0" + currentShortYear
+// formatWithYear formats t with layout, writing yearStr in place of the
+// layout's "2006" elements and shortYearStr in place of its "06" elements.
+func formatWithYear(t stdtime.Time, layout string, yearStr, shortYearStr []byte) string {
+	marked := markYearLayout(layout)
+	if marked == layout {
+		// No year elements (or a layout that cannot be marked)
+		return t.Format(layout)
 	}
 
+	formatted := t.Format(marked)
+
 	// Use pooled builder for final result to reduce allocations
-	// Estimate capacity: input length + potential expansion (max 4 extra chars for year replacement)
-	resultBuilder := builderPool.Get(len(formatted) + 4)
+	resultBuilder := builderPool.Get(len(formatted) + len(yearStr))
 	defer builderPool.Put(resultBuilder)
 
-	// Perform year replacement in a single pass using manual parsing
-	// This is more efficient than using regex for simple numeric replacements
-	i := 0
-	for i < len(formatted) {
-		// Check for 4-digit year pattern (word boundary)
-		if i+4 <= len(formatted) && formatted[i] >= '0' && formatted[i] <= '9' {
-			// Verify we have a 4-digit number
-			j := i
-			for j < i+4 && j < len(formatted) && formatted[j] >= '0' && formatted[j] <= '9' {
-				j++
-			}
-			if j-i == 4 {
-				// Check for word boundaries before and after
-				if isWordBoundaryBefore(formatted, i) && isWordBoundaryAfter(formatted, j) {
-					// This is a 4-digit year, replace it
-					resultBuilder.Write(yearStr)
-					i = j
-					continue
-				}
-			}
+	for i := 0; i < len(formatted); i++ {
+		switch formatted[i] {
+		case longYearSentinel:
+			resultBuilder.Write(yearStr)
+		case shortYearSentinel:
+			resultBuilder.Write(shortYearStr)
+		default:
+			resultBuilder.WriteByte(formatted[i])
 		}
+	}
+
+	return resultBuilder.String()
+}
 
-		// Check for 2-digit year pattern that matches current short year
-		if i+2 <= len(formatted) && formatted[i] >= '0' && formatted[i] <= '9' {
-			// Verify we have a 2-digit number
-			j := i
-			for j < i+2 && j < len(formatted) && formatted[j] >= '0' && formatted[j] <= '9' {
-				j++
+// FormatWithNames formats the time like Format, then replaces English month
+// and day names using the caller-provided maps. Keys are English names as
+// produced by the layout (e.g. "January", "Jan", "Monday") and values are
+// their replacements. Either map may be nil.
+//
+// This is intended for one-off localizations without registering a locale.
+// The maps are applied via an ad-hoc StringReplacer and do not affect the
+// global Thai replacers used by FormatLocale.
+func (t Time) FormatWithNames(layout string, monthNames, dayNames map[string]string) string {
+	formatted := t.Format(layout)
+
+	names := mergeMaps(monthNames, dayNames)
+	if len(names) == 0 {
+		return formatted
+	}
+	return internal.NewStringReplacer(names).Replace(formatted)
+}
+
+// Substitution records a single localization substitution made while
+// formatting, as reported by FormatTrace.
+type Substitution struct {
+	// Token is the layout element that produced the text (e.g. "January", "2006").
+	Token string
+	// Original is the text the standard library formats for the token.
+	Original string
+	// Replacement is the text that appears in the localized output instead.
+	Replacement string
+}
+
+// FormatTrace formats the time like FormatLocale and also reports which
+// layout tokens were substituted, in layout order. Only tokens whose output
+// changed are listed: month and weekday names translated for a registered
+// locale (see RegisterLocale), and years adjusted to a non-CE era.
+//
+// This is intended for debugging localization and for i18n tooling.
+func (t Time) FormatTrace(locale, layout string) (result string, substitutions []Substitution) {
+	result = t.FormatLocale(locale, layout)
+
+	era := t.Era()
+	names, localized := lookupLocale(locale)
+
+	for rest := layout; rest != ""; {
+		var token string
+		_, token, rest = nextLayoutElement(rest)
+
+		var original, replacement string
+		switch token {
+		case "January":
+			original = t.Time.Month().String()
+			replacement = original
+			if localized {
+				replacement = names.translate(names.months, original)
 			}
-			if j-i == 2 {
-				// Check for word boundaries before and after
-				if isWordBoundaryBefore(formatted, i) && isWordBoundaryAfter(formatted, j) {
-					// Check if this matches the current short year
-					if formatted[i:i+2] == currentShortYear {
-						resultBuilder.Write(shortYearStr)
-						i = j
-						continue
-					}
-				}
+		case "Jan":
+			original = t.Time.Month().String()[:3]
+			replacement = original
+			if localized {
+				replacement = names.translate(names.shortMonths, original)
+			}
+		case "Monday":
+			original = t.Time.Weekday().String()
+			replacement = original
+			if localized {
+				replacement = names.translate(names.days, original)
+			}
+		case "Mon":
+			original = t.Time.Weekday().String()[:3]
+			replacement = original
+			if localized {
+				replacement = names.translate(names.shortDays, original)
 			}
+		case "2006":
+			if era == CE() {
+				continue
+			}
+			original = string(appendPaddedInt(nil, t.Time.Year(), 4))
+			replacement = string(appendPaddedInt(nil, t.Year(), 4))
+		case "06":
+			if era == CE() {
+				continue
+			}
+			original = string(appendPaddedInt(nil, shortYear(t.Time.Year()), 2))
+			replacement = string(appendPaddedInt(nil, shortYear(t.Year()), 2))
+		default:
+			continue
 		}
 
-		// No match, copy current character
-		resultBuilder.WriteByte(formatted[i])
-		i++
+		if original != replacement {
+			substitutions = append(substitutions, Substitution{
+				Token:       token,
+				Original:    original,
+				Replacement: replacement,
+			})
+		}
 	}
 
-	return resultBuilder.String()
+	return result, substitutions
+}
+
+// thaiDigitZero is the Thai digit zero (๐, U+0E50). Thai digits ๐-๙
+// occupy the contiguous range U+0E50 to U+0E59.
+const thaiDigitZero = '\u0E50'
+
+// FormatThaiDigits formats the time using the Thai locale (see FormatLocale)
+// and renders every digit as a Thai numeral (๐-๙). The era year is
+// substituted before the digits are converted, so a BE time in 2024 renders
+// its year as "๒๕๖๗".
+//
+// All digits in the output are converted, including fractional seconds
+// produced by layouts such as ".000" or ".999999999", so a time with
+// 123 milliseconds formatted with "15:04:05.000" yields "๑๒:๓๐:๔๕.๑๒๓".
+// The decimal separator itself is kept as is.
+func (t Time) FormatThaiDigits(layout string) string {
+	return toThaiDigits(t.FormatLocale(LocaleThTH, layout))
+}
+
+// toThaiDigits replaces ASCII digits 0-9 with Thai digits ๐-๙ in a single pass.
+// Non-digit characters are copied unchanged.
+func toThaiDigits(s string) string {
+	// Each converted digit grows from 1 to 3 bytes in UTF-8
+	sb := builderPool.Get(len(s) * 3)
+	defer builderPool.Put(sb)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			sb.WriteRune(thaiDigitZero + rune(c-'0'))
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// normalizeThaiDigits replaces Thai digits ๐-๙ with ASCII digits 0-9 in a
+// single pass so that values such as "๑๕ มกราคม ๒๕๖๗" can be parsed.
+// The input is returned as is, without allocating, if it has no Thai
+// characters.
+func normalizeThaiDigits(s string) string {
+	// Thai digits are encoded in UTF-8 as 0xE0 0xB9 0x90 through 0xE0 0xB9 0x99
+	if !strings.Contains(s, "\xe0\xb9") {
+		return s
+	}
+
+	sb := builderPool.Get(len(s))
+	defer builderPool.Put(sb)
+
+	for i := 0; i < len(s); i++ {
+		if i+2 < len(s) && s[i] == 0xE0 && s[i+1] == 0xB9 && s[i+2] >= 0x90 && s[i+2] <= 0x99 {
+			sb.WriteByte('0' + s[i+2] - 0x90)
+			i += 2
+			continue
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// eraPlaceholder is replaced with the localized era name by FormatLocale
+// and by FormatWithEraStyle when using an EraFormat.FullFormat.
+const eraPlaceholder = "{era}"
+
+// replaceEraPlaceholder replaces every "{era}" placeholder in formatted
+// with eraName.
+//
+// If eraName is empty (as for CE), each placeholder is removed together with
+// one adjacent space, so "{era} 2024" becomes "2024" and "15 {era} 2024"
+// becomes "15 2024" rather than leaving stray or doubled spaces.
+func replaceEraPlaceholder(formatted, eraName string) string {
+	if eraName != "" {
+		return strings.ReplaceAll(formatted, eraPlaceholder, eraName)
+	}
+
+	if !strings.Contains(formatted, eraPlaceholder) {
+		return formatted
+	}
+
+	sb := builderPool.Get(len(formatted))
+	defer builderPool.Put(sb)
+
+	for {
+		idx := strings.Index(formatted, eraPlaceholder)
+		if idx < 0 {
+			sb.WriteString(formatted)
+			break
+		}
+
+		before := formatted[:idx]
+		after := formatted[idx+len(eraPlaceholder):]
+		if strings.HasPrefix(after, " ") {
+			// Drop the space following the placeholder
+			after = after[1:]
+		} else if strings.HasSuffix(before, " ") {
+			// Placeholder at the end of a word group: drop the preceding space
+			before = before[:len(before)-1]
+		}
+
+		sb.WriteString(before)
+		formatted = after
+	}
+	return sb.String()
 }
 
 // FormatEra formats the era name localized for the given locale.
@@ -444,6 +847,7 @@ func replaceYearInFormatted(formatted string, eraYear int) string {
 // With Reiwa era and locale "ja-JP", returns "令和".
 //
 // If no localized name exists for the locale, returns the default era name.
+// Returns an empty string for CE.
 func (t Time) FormatEra(locale string) string {
 	era := t.Era()
 	if era == nil || era == CE() {
@@ -452,6 +856,181 @@ func (t Time) FormatEra(locale string) string {
 	return era.NameForLocale(locale)
 }
 
+// FormatThaiLong formats the date in the long form used by Thai official
+// documents, such as "วันจันทร์ที่ 15 มกราคม พ.ศ. 2567".
+//
+// BE times show the year with the "พ.ศ." marker. All other times, including
+// CE, show the CE year with the "ค.ศ." marker, as in
+// "วันจันทร์ที่ 15 มกราคม ค.ศ. 2024".
+func (t Time) FormatThaiLong() string {
+	if t.Era() == BE() {
+		return t.FormatLocale(LocaleThTH, "วันMondayที่ 2 January "+thaiBEAbbreviation+" 2006")
+	}
+	return t.InEra(CE()).FormatLocale(LocaleThTH, "วันMondayที่ 2 January "+thaiCEAbbreviation+" 2006")
+}
+
+// thaiDayPrefix is the word "วัน" (day) that Thai writes before a weekday
+// name, as in "วันจันทร์".
+const thaiDayPrefix = "วัน"
+
+// FormatThaiWithDayPrefix formats the time like FormatLocale with th-TH, but
+// writes each full weekday name with the "วัน" prefix of Thai convention,
+// so "Monday, 2 January 2006" yields "วันจันทร์, 15 มกราคม 2567". Only the
+// "Monday" element is affected: abbreviated weekdays and month names are
+// translated as usual, and a weekday already preceded by "วัน" in the
+// layout is not prefixed twice.
+func (t Time) FormatThaiWithDayPrefix(layout string) string {
+	return t.FormatLocale(LocaleThTH, addThaiDayPrefix(layout))
+}
+
+// addThaiDayPrefix inserts "วัน" before each "Monday" element of layout
+// that does not already follow it.
+func addThaiDayPrefix(layout string) string {
+	if !strings.Contains(layout, "Monday") {
+		return layout
+	}
+
+	sb := builderPool.Get(len(layout) + 2*len(thaiDayPrefix))
+	defer builderPool.Put(sb)
+
+	rest := layout
+	for {
+		prefix, elem, suffix := nextLayoutElement(rest)
+		sb.WriteString(prefix)
+		if elem == "" {
+			break
+		}
+		if elem == "Monday" && !strings.HasSuffix(sb.String(), thaiDayPrefix) {
+			sb.WriteString(thaiDayPrefix)
+		}
+		sb.WriteString(elem)
+		rest = suffix
+	}
+	return sb.String()
+}
+
+// EraAbbrev returns the era abbreviation for the given locale. Unlike
+// FormatEra, it never returns an empty string: CE times yield "ค.ศ." for
+// th-TH and the CE era name (e.g. "CE") for other locales, and BE times
+// yield "พ.ศ." for th-TH.
+func (t Time) EraAbbrev(locale string) string {
+	return t.Era().NameForLocale(locale)
+}
+
+// Thai abbreviations for the Buddhist and Common eras, as used in Thai
+// official documents.
+const (
+	thaiBEAbbreviation = "พ.ศ."
+	thaiCEAbbreviation = "ค.ศ."
+)
+
+// shortYear returns the last two digits (0-99) of year. For negative years
+// the digits of the absolute value are used, so -11 yields 11 rather than
+// a negative remainder.
+func shortYear(year int) int {
+	short := year % 100
+	if short < 0 {
+		short = -short
+	}
+	return short
+}
+
+// appendPaddedInt appends v to buf, zero-padded to at least width digits.
+// Negative values are written with a leading '-' followed by the padded
+// absolute value, matching time.Time.Format for negative years.
+func appendPaddedInt(buf []byte, v int, width int) []byte {
+	u := uint64(v)
+	if v < 0 {
+		buf = append(buf, '-')
+		u = uint64(-v)
+	}
+
+	var digits [20]byte
+	n := len(digits)
+	for u >= 10 {
+		n--
+		digits[n] = byte('0' + u%10)
+		u /= 10
+	}
+	n--
+	digits[n] = byte('0' + u)
+
+	for w := len(digits) - n; w < width; w++ {
+		buf = append(buf, '0')
+	}
+	return append(buf, digits[n:]...)
+}
+
+// FormatDualYear formats the year in both Buddhist Era and Common Era,
+// as commonly shown in Thai official documents. The result does not depend
+// on the time's own era.
+//
+// For locale "th-TH" the output is "พ.ศ. 2567 (ค.ศ. 2024)"; for any other
+// locale it is "BE 2567 (CE 2024)".
+func (t Time) FormatDualYear(locale string) string {
+	ceYear := t.Time.Year()
+	beYear := BE().FromCE(ceYear)
+
+	beName, ceName := BE().NameForLocale(locale), CE().NameForLocale(locale)
+
+	sb := builderPool.Get(len(beName) + len(ceName) + 16)
+	defer builderPool.Put(sb)
+
+	sb.WriteString(beName)
+	sb.WriteByte(' ')
+	sb.WriteString(strconv.Itoa(beYear))
+	sb.WriteString(" (")
+	sb.WriteString(ceName)
+	sb.WriteByte(' ')
+	sb.WriteString(strconv.Itoa(ceYear))
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// bcePlaceholder is replaced by FormatBCE with the "BCE" or "CE" marker and
+// matched by ParseBCE.
+const bcePlaceholder = "{bce}"
+
+// historicalYear converts an astronomical CE year, as returned by YearCE, to
+// the positive year counted without a year zero and reports whether it is
+// BCE. Year 0 is 1 BCE and year -542 is 543 BCE.
+func historicalYear(year int) (int, bool) {
+	if year <= 0 {
+		return 1 - year, true
+	}
+	return year, false
+}
+
+// FormatBCE formats t in the Common Era regardless of its era, using
+// historical year numbering so that dates before 1 CE can be shown.
+// The layout's "2006" element is the year counted without a year zero and
+// without zero padding, "06" its last two digits, and the placeholder "{bce}"
+// is replaced with "BCE" for years before 1 CE and "CE" otherwise:
+//
+//	Date(-542, 1, 1, 0, 0, 0, 0, time.UTC).FormatBCE("2006 {bce}") // "543 BCE"
+//	Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).FormatBCE("2006 {bce}") // "2024 CE"
+//
+// Without the placeholder, years before 1 CE cannot be told apart from CE
+// years; use ParseBCE to parse the result.
+func (t Time) FormatBCE(layout string) string {
+	year, bce := historicalYear(t.Time.Year())
+
+	var yearBuf [20]byte
+	var shortYearBuf [2]byte
+	formatted := formatWithYear(t.Time, layout,
+		strconv.AppendInt(yearBuf[:0], int64(year), 10),
+		appendPaddedInt(shortYearBuf[:0], shortYear(year), 2))
+
+	if !strings.Contains(formatted, bcePlaceholder) {
+		return formatted
+	}
+	marker := "CE"
+	if bce {
+		marker = "BCE"
+	}
+	return strings.ReplaceAll(formatted, bcePlaceholder, marker)
+}
+
 // FormatWithEraStyle formats the time using era-specific rules.
 // It respects the era's format settings (prefix, suffix, year digits)
 // and localizes the era name if available.
@@ -487,6 +1066,26 @@ func (t Time) FormatWithEraStyle(locale string, layout string) string {
 	return formatWithEraAdjustments(t, locale, layout, era)
 }
 
+// FormatWithEraStyleChecked is like FormatWithEraStyle but returns an
+// EraMismatchError when t's era was not in effect on t's date, as reported
+// by Era.IsValidForDate, instead of rendering a meaningless era year (such
+// as a zero or negative Reiwa year for a date in 1850).
+//
+// The error's ExpectedEra is the era of the same family in effect on the
+// date, if one is registered, and nil otherwise.
+func (t Time) FormatWithEraStyleChecked(locale string, layout string) (string, error) {
+	era := t.Era()
+	if !era.IsValidForDate(t.Time) {
+		var expected *Era
+		if family := era.Family(); family != "" {
+			expected = GetEraForDate(t.Time, family)
+		}
+		details := t.Time.Format(stdtime.RFC3339) + " is outside the era's date range"
+		return "", newEraMismatchError(expected, era, details)
+	}
+	return t.FormatWithEraStyle(locale, layout), nil
+}
+
 // formatWithEraFullFormat formats using a custom full format string.
 func formatWithEraFullFormat(t Time, locale string, fullFormat string) string {
 	// Replace era name placeholder if present
@@ -496,34 +1095,29 @@ func formatWithEraFullFormat(t Time, locale string, fullFormat string) string {
 	baseFormatted := t.Time.Format(fullFormat)
 
 	// Replace era name
-	if eraName != "" {
-		baseFormatted = strings.Replace(baseFormatted, "{era}", eraName, 1)
-	}
-
-	return baseFormatted
+	return replaceEraPlaceholder(baseFormatted, eraName)
 }
 
 // formatWithEraAdjustments formats with era prefix/suffix adjustments.
 func formatWithEraAdjustments(t Time, locale string, layout string, era *Era) string {
-	// Get the formatted base time
-	baseFormatted := t.Time.Format(layout)
-
 	// Apply era-specific formatting to the year
 	eraYear := era.FromCE(t.Time.Year())
 
-	// Handle zero-based years (e.g., Japanese era year 1 = "元年")
-	if era.format != nil && era.format.ZeroBased {
-		// Adjust year number (year 1 in era is year 0 in calculation)
-		if eraYear == 1 {
-			eraYear = 0
-		}
+	// Handle zero-based years (year 1 in era is year 0 in calculation)
+	firstYear := eraYear == 1
+	if era.format != nil && era.format.ZeroBased && firstYear {
+		eraYear = 0
 	}
 
-	// Build the era-formatted year
+	// Build the era-formatted year; the first year of a gannen era is
+	// rendered as "元" whether or not the era counts from zero
 	var eraYearStr string
-	if era.format != nil {
+	switch {
+	case era.format != nil && era.format.Gannen && firstYear:
+		eraYearStr = gannenYear
+	case era.format != nil:
 		eraYearStr = formatEraYear(eraYear, era.format)
-	} else {
+	default:
 		eraYearStr = strconv.Itoa(eraYear)
 	}
 
@@ -535,21 +1129,57 @@ func formatWithEraAdjustments(t Time, locale string, layout string, era *Era) st
 	result.WriteString(eraYearStr)
 	if era.format != nil && era.format.Suffix != "" {
 		result.WriteString(era.format.Suffix)
+		layout = trimYearSuffix(layout, era.format.Suffix)
 	}
 
-	// Replace the year in the formatted output
-	return replaceYearInFormattedWithEraString(baseFormatted, eraYearStr)
+	// Write the prefixed and suffixed era year at the layout's year
+	// position; a short "06" year uses the last two digits, keeping "元"
+	// as is so the gannen year is never re-expanded to a number
+	var shortYearBuf [2]byte
+	short := appendPaddedInt(shortYearBuf[:0], shortYear(eraYear), 2)
+	if eraYearStr == gannenYear {
+		short = []byte(gannenYear)
+	}
+	return formatWithYear(t.Time, layout, []byte(result.String()), short)
 }
 
+// trimYearSuffix removes suffix where the layout already writes it right
+// after a "2006" element, so that "2006年01月02日" renders the era year with
+// a single 年.
+func trimYearSuffix(layout, suffix string) string {
+	if !strings.Contains(layout, "2006"+suffix) {
+		return layout
+	}
+
+	var sb strings.Builder
+	for layout != "" {
+		prefix, elem, rest := nextLayoutElement(layout)
+		sb.WriteString(prefix)
+		sb.WriteString(elem)
+		if elem == "2006" {
+			rest = strings.TrimPrefix(rest, suffix)
+		}
+		layout = rest
+	}
+	return sb.String()
+}
+
+// gannenYear is the Japanese rendering of the first year of an era (元年).
+const gannenYear = "元"
+
 // formatEraYear formats the era year according to the format settings.
 func formatEraYear(year int, format *EraFormat) string {
+	if format.Gannen && year == 1 {
+		return gannenYear // Japanese gannen - first year
+	}
+
 	yearStr := strconv.Itoa(year)
 
 	switch format.YearDigits {
 	case 1:
 		// Single digit (gannen style for year 1)
 		if year == 1 {
-			return "元" // Japanese gannen - first year
+			return gannenYear // Japanese gannen - first year
 		}
 		if year < 10 {
 			return yearStr
@@ -573,26 +1203,6 @@ func formatEraYear(year int, format *EraFormat) string {
 	}
 }
 
-// replaceYearInFormattedWithEraString replaces year numbers with era-specific string.
-func replaceYearInFormattedWithEraString(formatted string, eraYearStr string) string {
-	// Use the standard replace function but with era year string
-	return replaceYearInFormatted(formatted, parseEraYear(eraYearStr))
-}
-
-// parseEraYear parses an era year string to an integer.
-func parseEraYear(s string) int {
-	// Handle Japanese "元" (gannen/first year)
-	if s == "元" {
-		return 1
-	}
-
-	n, err := strconv.Atoi(s)
-	if err != nil {
-		return 0
-	}
-	return n
-}
-
 // EraFormatStats returns formatting statistics for an era.
 // This can be used to monitor formatting performance.
 type EraFormatStats struct {
@@ -392,3 +392,547 @@ func TestFormatCenturyBoundary(t *testing.T) {
 		})
 	}
 }
+
+// TestFormatDualYear tests rendering of BE and CE years together
+func TestFormatDualYear(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		locale   string
+		expected string
+	}{
+		{"Thai locale BE time", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), LocaleThTH, "พ.ศ. 2567 (ค.ศ. 2024)"},
+		{"Thai locale CE time", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), LocaleThTH, "พ.ศ. 2567 (ค.ศ. 2024)"},
+		{"English locale", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), LocaleEnUS, "BE 2567 (CE 2024)"},
+		{"Default locale", Date(2000, 1, 1, 0, 0, 0, 0, stdtime.UTC), LocaleDefault, "BE 2543 (CE 2000)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.tm.FormatDualYear(tt.locale)
+			if result != tt.expected {
+				t.Errorf("FormatDualYear(%q) = %q, want %q", tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatThaiDigitsFractionalSeconds tests that fractional seconds are
+// rendered in Thai digits along with the rest of the output
+func TestFormatThaiDigitsFractionalSeconds(t *testing.T) {
+	tm := Date(2024, 2, 29, 12, 30, 45, 123456789, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{"15:04:05.000", "๑๒:๓๐:๔๕.๑๒๓"},
+		{"15:04:05.000000", "๑๒:๓๐:๔๕.๑๒๓๔๕๖"},
+		{"15:04:05.999999999", "๑๒:๓๐:๔๕.๑๒๓๔๕๖๗๘๙"},
+		{"02/01/2006 15:04:05.000", "๒๙/๐๒/๒๕๖๗ ๑๒:๓๐:๔๕.๑๒๓"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			if got := tm.FormatThaiDigits(tt.layout); got != tt.expected {
+				t.Errorf("FormatThaiDigits(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatThaiDigitsFullDate tests that full date layouts are rendered with
+// the era year first and then converted, leaving no Latin digits
+func TestFormatThaiDigitsFullDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		layout   string
+		expected string
+	}{
+		{
+			name:     "BE long date",
+			tm:       Date(2024, 1, 15, 9, 5, 0, 0, stdtime.UTC).InEra(BE()),
+			layout:   "Monday 2 January 2006 15:04",
+			expected: "จันทร์ ๑๕ มกราคม ๒๕๖๗ ๐๙:๐๕",
+		},
+		{
+			name:     "BE short year",
+			tm:       Date(2024, 12, 31, 0, 0, 0, 0, stdtime.UTC).InEra(BE()),
+			layout:   "02/01/06",
+			expected: "๓๑/๑๒/๖๗",
+		},
+		{
+			name:     "CE date",
+			tm:       Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC),
+			layout:   "2006-01-02",
+			expected: "๒๐๒๔-๐๑-๑๕",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.tm.FormatThaiDigits(tt.layout)
+			if got != tt.expected {
+				t.Errorf("FormatThaiDigits(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+			if strings.ContainsAny(got, "0123456789") {
+				t.Errorf("FormatThaiDigits(%q) = %q, contains Latin digits", tt.layout, got)
+			}
+		})
+	}
+}
+
+// TestFormatThaiLong tests the Thai official long date form
+func TestFormatThaiLong(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		expected string
+	}{
+		{"BE Monday", Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "วันจันทร์ที่ 15 มกราคม พ.ศ. 2567"},
+		{"BE leap day", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "วันพฤหัสบดีที่ 29 กุมภาพันธ์ พ.ศ. 2567"},
+		{"BE single digit day", Date(2023, 12, 5, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "วันอังคารที่ 5 ธันวาคม พ.ศ. 2566"},
+		{"CE", Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC), "วันจันทร์ที่ 15 มกราคม ค.ศ. 2024"},
+		{"ROC shows CE", Date(2024, 5, 1, 0, 0, 0, 0, stdtime.UTC).InEra(ROC()), "วันพุธที่ 1 พฤษภาคม ค.ศ. 2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatThaiLong(); got != tt.expected {
+				t.Errorf("FormatThaiLong() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestEraAbbrev tests that era abbreviations are never empty
+func TestEraAbbrev(t *testing.T) {
+	ceTime := Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC)
+	beTime := ceTime.InEra(BE())
+
+	tests := []struct {
+		name     string
+		tm       Time
+		locale   string
+		expected string
+	}{
+		{"CE th-TH", ceTime, "th-TH", "ค.ศ."},
+		{"CE en-US", ceTime, "en-US", "CE"},
+		{"BE th-TH", beTime, "th-TH", "พ.ศ."},
+		{"BE en-US", beTime, "en-US", "BE"},
+		{"ROC en-US", ceTime.InEra(ROC()), "en-US", "ROC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.EraAbbrev(tt.locale); got != tt.expected {
+				t.Errorf("EraAbbrev(%q) = %q, want %q", tt.locale, got, tt.expected)
+			}
+		})
+	}
+
+	// FormatEra keeps returning an empty string for CE
+	if got := ceTime.FormatEra("th-TH"); got != "" {
+		t.Errorf("FormatEra(th-TH) for CE = %q, want empty", got)
+	}
+}
+
+// TestFormatWithNames tests formatting with caller-provided name maps
+func TestFormatWithNames(t *testing.T) {
+	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE())
+
+	upperMonths := map[string]string{
+		"February": "FEBRUARY",
+		"Feb":      "FEB",
+	}
+	upperDays := map[string]string{
+		"Thursday": "THURSDAY",
+		"Thu":      "THU",
+	}
+
+	tests := []struct {
+		name     string
+		layout   string
+		months   map[string]string
+		days     map[string]string
+		expected string
+	}{
+		{"Full names", "Monday 02 January 2006", upperMonths, upperDays, "THURSDAY 29 FEBRUARY 2567"},
+		{"Short names", "Mon 02 Jan 2006", upperMonths, upperDays, "THU 29 FEB 2567"},
+		{"Months only", "Monday 02 January 2006", upperMonths, nil, "Thursday 29 FEBRUARY 2567"},
+		{"No maps", "02 January 2006", nil, nil, "29 February 2567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tm.FormatWithNames(tt.layout, tt.months, tt.days); got != tt.expected {
+				t.Errorf("FormatWithNames(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+
+	// Global Thai locale must be unaffected
+	if got := tm.FormatLocale(LocaleThTH, "02 January 2006"); got != "29 กุมภาพันธ์ 2567" {
+		t.Errorf("FormatLocale(th-TH) after FormatWithNames = %q, want %q", got, "29 กุมภาพันธ์ 2567")
+	}
+}
+
+// TestFormatDateOnlyFastPath tests that the "2006-01-02" fast path matches
+// the general layout-based path
+func TestFormatDateOnlyFastPath(t *testing.T) {
+	dates := []Time{
+		Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC),
+		Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+		Date(2024, 12, 31, 23, 59, 59, 0, stdtime.UTC),
+		Date(2000, 10, 9, 0, 0, 0, 0, stdtime.UTC),
+		Date(1900, 2, 28, 0, 0, 0, 0, stdtime.UTC),
+		Date(2099, 7, 4, 0, 0, 0, 0, stdtime.UTC),
+	}
+
+	for _, d := range dates {
+		beTime := d.InEra(BE())
+		general := formatWithEraYear(beTime.Time, "2006-01-02", beTime.Year())
+		if got := beTime.Format("2006-01-02"); got != general {
+			t.Errorf("Format(2006-01-02) for %v = %q, want %q", d.Time, got, general)
+		}
+	}
+
+	if got := Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()).Format("2006-01-02"); got != "2567-02-29" {
+		t.Errorf("Format(2006-01-02) = %q, want %q", got, "2567-02-29")
+	}
+}
+
+// TestFormatLocaleEraPlaceholder tests the {era} placeholder in FormatLocale
+func TestFormatLocaleEraPlaceholder(t *testing.T) {
+	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		tm       Time
+		locale   string
+		layout   string
+		expected string
+	}{
+		{"BE Thai locale", tm.InEra(BE()), LocaleThTH, "{era} 2006", "พ.ศ. 2567"},
+		{"BE Thai full date", tm.InEra(BE()), LocaleThTH, "02 January {era} 2006", "29 กุมภาพันธ์ พ.ศ. 2567"},
+		{"BE English locale", tm.InEra(BE()), LocaleEnUS, "{era} 2006", "BE 2567"},
+		{"Multiple placeholders", tm.InEra(BE()), LocaleThTH, "{era}2006/{era}", "พ.ศ.2567/พ.ศ."},
+		{"CE is empty", tm, LocaleThTH, "{era}2006", "2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatLocale(tt.locale, tt.layout); got != tt.expected {
+				t.Errorf("FormatLocale(%q, %q) = %q, want %q", tt.locale, tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatLocaleEraPlaceholderCollapses tests that an empty era word leaves
+// no stray placeholder or spaces for CE times
+func TestFormatLocaleEraPlaceholderCollapses(t *testing.T) {
+	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
+
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{"{era} 2006", "2024"},
+		{"2006 {era}", "2024"},
+		{"02 January {era} 2006", "29 กุมภาพันธ์ 2024"},
+		{"{era}", ""},
+		{"({era}) 2006", "() 2024"},
+		{"{era} {era} 2006", "2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			got := tm.FormatLocale(LocaleThTH, tt.layout)
+			if got != tt.expected {
+				t.Errorf("FormatLocale(th-TH, %q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+			if strings.Contains(got, "  ") || strings.Contains(got, eraPlaceholder) {
+				t.Errorf("FormatLocale(th-TH, %q) = %q contains leftover placeholder or double space", tt.layout, got)
+			}
+		})
+	}
+}
+
+// TestFormatShortYearNegativeAndLarge tests two-digit year output for
+// negative and very large era years
+func TestFormatShortYearNegativeAndLarge(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		layout   string
+		expected string
+	}{
+		{"Negative era year two-digit", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(&Era{name: "NEG", offset: -2035}), "02/01/06", "15/06/11"},
+		{"Negative era year four-digit", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(&Era{name: "NEG", offset: -2035}), "2006", "-0011"},
+		{"Negative CE year in BE", Date(-600, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "06", "57"},
+		{"Very large BE year two-digit", Date(99999, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "06", "42"},
+		{"Small era year four-digit", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(&Era{name: "SMALL", offset: -1480}), "2006", "0544"},
+		{"Single digit era year two-digit", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(&Era{name: "ONE", offset: -2019}), "06", "05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.Format(tt.layout); got != tt.expected {
+				t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatYearPositionAware tests that only the layout's year elements are
+// replaced with the era year, not other 4-digit or 2-digit numbers
+func TestFormatYearPositionAware(t *testing.T) {
+	// 20:24 and 0.2024s produce "2024" in non-year positions
+	tm := Date(2024, 6, 24, 20, 24, 24, 202400000, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{"1504", "2024"},
+		{"2006 1504", "2567 2024"},
+		{"15:04:05.0000", "20:24:24.2024"},
+		{"2006-01-02 15:04:05.0000", "2567-06-24 20:24:24.2024"},
+		{"02/01/06 15:04", "24/06/67 20:24"},
+		{"_2006", "_2567"},
+		{"Jan 2, 2006 at 3:04pm", "Jun 24, 2567 at 8:24pm"},
+		{"15:04:05,999999999", "20:24:24,2024"},
+		{"Z07:00 2006", "Z 2567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			if got := tm.Format(tt.layout); got != tt.expected {
+				t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("Thai locale", func(t *testing.T) {
+		if got := tm.FormatLocale(LocaleThTH, "2 January 2006 1504"); got != "24 มิถุนายน 2567 2024" {
+			t.Errorf("FormatLocale(th-TH) = %q, want %q", got, "24 มิถุนายน 2567 2024")
+		}
+	})
+}
+
+// TestFormatParseDayElementsWithEraYear tests era years next to the
+// space-padded day ("_2"), day-of-year ("002") and space-padded day-of-year
+// ("__2") elements
+func TestFormatParseDayElementsWithEraYear(t *testing.T) {
+	// 9 February is day 40 of the year
+	ce := Date(2024, 2, 9, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		era      *Era
+		layout   string
+		expected string
+	}{
+		{"BE padded day", BE(), "_2 Jan 2006", " 9 Feb 2567"},
+		{"BE padded day after year", BE(), "2006 Jan _2", "2567 Feb  9"},
+		{"BE day of year", BE(), "2006-002", "2567-040"},
+		{"BE day of year before year", BE(), "002/2006", "040/2567"},
+		{"BE padded day of year", BE(), "2006 __2", "2567  40"},
+		{"BE padded day of year adjacent", BE(), "2006__2", "2567 40"},
+		{"BE underscore before year", BE(), "002_2006", "040_2567"},
+		{"ROC day of year", ROC(), "2006.002", "0113.040"},
+		{"ROC padded day of year", ROC(), "__2 2006", " 40 0113"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := ce.InEra(tt.era)
+			formatted := tm.Format(tt.layout)
+			if formatted != tt.expected {
+				t.Fatalf("Format(%q) = %q, want %q", tt.layout, formatted, tt.expected)
+			}
+
+			parsed, err := ParseWithEra(tt.layout, formatted, tt.era)
+			if err != nil {
+				t.Fatalf("ParseWithEra(%q, %q) unexpected error: %v", tt.layout, formatted, err)
+			}
+			if !parsed.Time.Equal(ce.Time) {
+				t.Errorf("ParseWithEra(%q, %q) = %v, want %v", tt.layout, formatted, parsed.Time, ce.Time)
+			}
+		})
+	}
+}
+
+// TestNextLayoutElement tests that layout elements are split like the
+// standard library does
+func TestNextLayoutElement(t *testing.T) {
+	tests := []struct {
+		layout string
+		prefix string
+		elem   string
+		suffix string
+	}{
+		{"2006-01-02", "", "2006", "-01-02"},
+		{"Year: 06", "Year: ", "06", ""},
+		{"_2006", "_", "2006", ""},
+		{"__2 002", "", "__2", " 002"},
+		{"Janet", "Janet", "", ""},
+		{"Jan.", "", "Jan", "."},
+		{"Mondays", "", "Monday", "s"},
+		{".0006", ".00", "06", ""},
+		{".000 2006", "", ".000", " 2006"},
+		{"-07:00:00Z", "", "-07:00:00", "Z"},
+		{"no elements", "no elements", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			prefix, elem, suffix := nextLayoutElement(tt.layout)
+			if prefix != tt.prefix || elem != tt.elem || suffix != tt.suffix {
+				t.Errorf("nextLayoutElement(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.layout, prefix, elem, suffix, tt.prefix, tt.elem, tt.suffix)
+			}
+		})
+	}
+}
+
+// TestFormatTrace tests that substitutions made during formatting are reported
+func TestFormatTrace(t *testing.T) {
+	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE())
+
+	result, subs := tm.FormatTrace(LocaleThTH, "Monday 02 January 2006")
+	if result != "พฤหัสบดี 29 กุมภาพันธ์ 2567" {
+		t.Errorf("FormatTrace() result = %q, want %q", result, "พฤหัสบดี 29 กุมภาพันธ์ 2567")
+	}
+
+	expected := []Substitution{
+		{Token: "Monday", Original: "Thursday", Replacement: "พฤหัสบดี"},
+		{Token: "January", Original: "February", Replacement: "กุมภาพันธ์"},
+		{Token: "2006", Original: "2024", Replacement: "2567"},
+	}
+	if len(subs) != len(expected) {
+		t.Fatalf("FormatTrace() returned %d substitutions %v, want %d", len(subs), subs, len(expected))
+	}
+	for i, exp := range expected {
+		if subs[i] != exp {
+			t.Errorf("substitutions[%d] = %+v, want %+v", i, subs[i], exp)
+		}
+	}
+
+	// Short names
+	_, subs = tm.FormatTrace(LocaleThTH, "Mon Jan")
+	if len(subs) != 2 || subs[0].Replacement != "พฤ." || subs[1].Replacement != "ก.พ." {
+		t.Errorf("FormatTrace(Mon Jan) substitutions = %+v", subs)
+	}
+
+	// Nothing is substituted for CE in a non-Thai locale
+	result, subs = Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).FormatTrace(LocaleEnUS, "Monday 02 January 2006")
+	if len(subs) != 0 {
+		t.Errorf("FormatTrace() for CE en-US substitutions = %+v, want none", subs)
+	}
+	if result != "Thursday 29 February 2024" {
+		t.Errorf("FormatTrace() for CE en-US result = %q", result)
+	}
+}
+
+// TestFormatThaiWithDayPrefix tests the "วัน" prefix on Thai weekday names
+func TestFormatThaiWithDayPrefix(t *testing.T) {
+	tm := Date(2024, 1, 15, 9, 30, 0, 0, stdtime.UTC).InEra(BE()) // Monday
+
+	tests := []struct {
+		name     string
+		layout   string
+		expected string
+	}{
+		{"Weekday", "Monday", "วันจันทร์"},
+		{"Full date", "Monday, 2 January 2006", "วันจันทร์, 15 มกราคม 2567"},
+		{"Already prefixed", "วันMondayที่ 2 January 2006", "วันจันทร์ที่ 15 มกราคม 2567"},
+		{"Short weekday unchanged", "Mon 2 Jan 06", "จ. 15 ม.ค. 67"},
+		{"No weekday", "2 January 2006 15:04", "15 มกราคม 2567 09:30"},
+		{"Two weekdays", "Monday/Monday", "วันจันทร์/วันจันทร์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tm.FormatThaiWithDayPrefix(tt.layout); got != tt.expected {
+				t.Errorf("FormatThaiWithDayPrefix(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+
+	// FormatLocale itself is unchanged
+	if got := tm.FormatLocale(LocaleThTH, "Monday"); got != "จันทร์" {
+		t.Errorf("FormatLocale(Monday) = %q, want %q", got, "จันทร์")
+	}
+}
+
+// TestFormatBCE tests historical year numbering with the {bce} placeholder
+func TestFormatBCE(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		layout   string
+		expected string
+	}{
+		{"543 BCE", Date(-542, 1, 1, 0, 0, 0, 0, stdtime.UTC), "2006 {bce}", "543 BCE"},
+		{"Year 0 is 1 BCE", Date(0, 6, 15, 0, 0, 0, 0, stdtime.UTC), "02/01/2006 {bce}", "15/06/1 BCE"},
+		{"1 CE", Date(1, 1, 1, 0, 0, 0, 0, stdtime.UTC), "2006 {bce}", "1 CE"},
+		{"Era is ignored", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "2 Jan 2006 {bce}", "29 Feb 2024 CE"},
+		{"Short year", Date(-543, 3, 1, 0, 0, 0, 0, stdtime.UTC), "02/01/06 {bce}", "01/03/44 BCE"},
+		{"No placeholder", Date(-542, 1, 1, 0, 0, 0, 0, stdtime.UTC), "2006-01-02", "543-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatBCE(tt.layout); got != tt.expected {
+				t.Errorf("FormatBCE(%q) = %q, want %q", tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeThaiText tests cleanup of Thai text variations
+func TestNormalizeThaiText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Zero-width spaces", "15\u200Bมกราคม\u200B2567", "15มกราคม2567"},
+		{"Zero-width space inside month", "กุมภา\u200Bพันธ์", "กุมภาพันธ์"},
+		{"Missing thanthakhat", "29 กุมภาพันธ 2567", "29 กุมภาพันธ์ 2567"},
+		{"Canonical February unchanged", "29 กุมภาพันธ์ 2567", "29 กุมภาพันธ์ 2567"},
+		{"Nikhahit and sara aa", "น\u0E49\u0E4D\u0E32", "น\u0E49\u0E33"},
+		{"Nikhahit before tone mark", "น\u0E4D\u0E49\u0E32", "น\u0E49\u0E33"},
+		{"Tone mark before sara u", "ป\u0E48\u0E38", "ป\u0E38\u0E48"},
+		{"ASCII unchanged", "15 January 2024", "15 January 2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeThaiText(tt.input); got != tt.expected {
+				t.Errorf("NormalizeThaiText(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNormalizeThaiTextStrict tests rejection of invalid Thai sequences
+func TestNormalizeThaiTextStrict(t *testing.T) {
+	if got, err := NormalizeThaiTextStrict("29 กุมภา\u200Bพันธ 2567"); err != nil || got != "29 กุมภาพันธ์ 2567" {
+		t.Errorf("NormalizeThaiTextStrict() = %q, %v; want %q, nil", got, err, "29 กุมภาพันธ์ 2567")
+	}
+
+	for _, input := range []string{
+		"\u0E48มกราคม",   // tone mark at the start
+		"15 \u0E38 2567", // below-vowel after a space
+		"ก\u0E48\u0E49",  // two tone marks
+	} {
+		_, err := NormalizeThaiTextStrict(input)
+		if !IsThaiTextError(err) {
+			t.Errorf("NormalizeThaiTextStrict(%q) error = %v, want ThaiTextError", input, err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Package time provides human-readable relative times and durations in Thai
+// and English, such as "3 ชั่วโมงที่แล้ว" or "2 hours 30 minutes".
+package time
+
+import (
+	"strconv"
+	"sync"
+	stdtime "time"
+)
+
+var (
+	// humanizeReferenceDate is the reference date Humanize measures from.
+	// If zero, time.Now() is used. This enables deterministic testing.
+	humanizeReferenceDate stdtime.Time
+	humanizeMu            sync.RWMutex
+)
+
+// SetHumanizeReferenceDate sets the reference date that Humanize measures
+// relative times from. This is useful for deterministic testing.
+// Pass a zero time.Time to use time.Now().
+func SetHumanizeReferenceDate(t stdtime.Time) {
+	humanizeMu.Lock()
+	defer humanizeMu.Unlock()
+	humanizeReferenceDate = t
+}
+
+// timeUnit is a unit of elapsed time with its localized names.
+type timeUnit struct {
+	size     stdtime.Duration
+	singular string // en-US singular, e.g. "hour"
+	plural   string // en-US plural, e.g. "hours"
+	thai     string // th-TH name, e.g. "ชั่วโมง"
+}
+
+// Approximate lengths of calendar units used for relative times.
+const (
+	approxDay   = 24 * stdtime.Hour
+	approxMonth = 30 * approxDay
+	approxYear  = 365 * approxDay
+)
+
+// humanizeUnits are the coarse buckets used by Humanize, largest first.
+// Months and years are approximated as 30 and 365 days.
+var humanizeUnits = []timeUnit{
+	{approxYear, "year", "years", "ปี"},
+	{approxMonth, "month", "months", "เดือน"},
+	{approxDay, "day", "days", "วัน"},
+	{stdtime.Hour, "hour", "hours", "ชั่วโมง"},
+	{stdtime.Minute, "minute", "minutes", "นาที"},
+	{stdtime.Second, "second", "seconds", "วินาที"},
+}
+
+// justNowThreshold is the distance below which Humanize reports "just now".
+const justNowThreshold = 10 * stdtime.Second
+
+// Humanize describes t relative to the current time (or the date set with
+// SetHumanizeReferenceDate) in coarse units, such as "3 hours ago" or
+// "in 2 days" for en-US and "3 ชั่วโมงที่แล้ว" or "อีก 2 วัน" for th-TH.
+// Times within 10 seconds are described as "just now" ("เมื่อสักครู่").
+// Other locales use English.
+//
+// Only the largest whole unit is shown; months and years are approximated
+// as 30 and 365 days.
+func (t Time) Humanize(locale string) string {
+	humanizeMu.RLock()
+	ref := humanizeReferenceDate
+	humanizeMu.RUnlock()
+
+	if ref.IsZero() {
+		ref = stdtime.Now()
+	}
+
+	delta := ref.Sub(t.Time)
+	future := delta < 0
+	if future {
+		delta = -delta
+	}
+
+	thai := locale == LocaleThTH
+	if delta < justNowThreshold {
+		if thai {
+			return "เมื่อสักครู่"
+		}
+		return "just now"
+	}
+
+	unit := humanizeUnits[len(humanizeUnits)-1]
+	for _, u := range humanizeUnits {
+		if delta >= u.size {
+			unit = u
+			break
+		}
+	}
+	count := int64(delta / unit.size)
+	amount := formatUnitAmount(count, unit, locale)
+
+	switch {
+	case thai && future:
+		return "อีก " + amount
+	case thai:
+		return amount + "ที่แล้ว"
+	case future:
+		return "in " + amount
+	default:
+		return amount + " ago"
+	}
+}
+
+// formatUnitAmount renders count of unit, such as "3 hours" for en-US or
+// "3 ชั่วโมง" for th-TH.
+func formatUnitAmount(count int64, unit timeUnit, locale string) string {
+	name := unit.plural
+	switch {
+	case locale == LocaleThTH:
+		name = unit.thai
+	case count == 1:
+		name = unit.singular
+	}
+	return strconv.FormatInt(count, 10) + " " + name
+}
+
+// durationUnits are the components rendered by FormatDuration, largest first.
+var durationUnits = []timeUnit{
+	{approxDay, "day", "days", "วัน"},
+	{stdtime.Hour, "hour", "hours", "ชั่วโมง"},
+	{stdtime.Minute, "minute", "minutes", "นาที"},
+	{stdtime.Second, "second", "seconds", "วินาที"},
+	{stdtime.Millisecond, "millisecond", "milliseconds", "มิลลิวินาที"},
+	{stdtime.Microsecond, "microsecond", "microseconds", "ไมโครวินาที"},
+	{stdtime.Nanosecond, "nanosecond", "nanoseconds", "นาโนวินาที"},
+}
+
+// FormatDuration renders d as text, such as "2 hours 30 minutes" for en-US
+// or "2 ชั่วโมง 30 นาที" for th-TH. Other locales use English. Components
+// from days down to nanoseconds are shown, omitting zero components.
+// Negative durations are prefixed with "-", and a zero duration is
+// rendered as "0 seconds".
+//
+// The optional maxUnits limits the output to the given number of largest
+// non-zero components; smaller components are truncated, not rounded:
+//
+//	FormatDuration(26*time.Hour+90*time.Second, "en-US", 2) // "1 day 2 hours"
+func FormatDuration(d stdtime.Duration, locale string, maxUnits ...int) string {
+	limit := len(durationUnits)
+	if len(maxUnits) > 0 && maxUnits[0] > 0 {
+		limit = maxUnits[0]
+	}
+
+	if d == 0 {
+		return formatUnitAmount(0, durationUnits[3], locale) // seconds
+	}
+
+	sb := builderPool.Get(64)
+	defer builderPool.Put(sb)
+
+	// Work with the magnitude as uint64 so that the minimum Duration,
+	// whose negation overflows, is handled
+	remaining := uint64(d)
+	if d < 0 {
+		sb.WriteByte('-')
+		remaining = uint64(-(d + 1)) + 1
+	}
+
+	written := 0
+	for _, unit := range durationUnits {
+		if written == limit {
+			break
+		}
+		count := remaining / uint64(unit.size)
+		if count == 0 {
+			continue
+		}
+		remaining -= count * uint64(unit.size)
+
+		if written > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(formatUnitAmount(int64(count), unit, locale))
+		written++
+	}
+	return sb.String()
+}
@@ -0,0 +1,80 @@
+package time
+
+import (
+	"testing"
+	stdtime "time"
+)
+
+// TestHumanize tests relative time rendering in Thai and English
+func TestHumanize(t *testing.T) {
+	ref := stdtime.Date(2024, 6, 15, 12, 0, 0, 0, stdtime.UTC)
+	SetHumanizeReferenceDate(ref)
+	defer SetHumanizeReferenceDate(stdtime.Time{})
+
+	tests := []struct {
+		name     string
+		offset   stdtime.Duration
+		locale   string
+		expected string
+	}{
+		{"Just now", -3 * stdtime.Second, LocaleEnUS, "just now"},
+		{"Just now Thai", 0, LocaleThTH, "เมื่อสักครู่"},
+		{"Seconds", -45 * stdtime.Second, LocaleEnUS, "45 seconds ago"},
+		{"1 minute", -stdtime.Minute, LocaleEnUS, "1 minute ago"},
+		{"1 minute Thai", -stdtime.Minute, LocaleThTH, "1 นาทีที่แล้ว"},
+		{"3 hours", -3*stdtime.Hour - 20*stdtime.Minute, LocaleEnUS, "3 hours ago"},
+		{"3 hours Thai", -3 * stdtime.Hour, LocaleThTH, "3 ชั่วโมงที่แล้ว"},
+		{"2 days", -48 * stdtime.Hour, LocaleEnUS, "2 days ago"},
+		{"2 days Thai", -48 * stdtime.Hour, LocaleThTH, "2 วันที่แล้ว"},
+		{"2 months", -61 * 24 * stdtime.Hour, LocaleEnUS, "2 months ago"},
+		{"1 year", -366 * 24 * stdtime.Hour, LocaleEnUS, "1 year ago"},
+		{"1 year Thai", -366 * 24 * stdtime.Hour, LocaleThTH, "1 ปีที่แล้ว"},
+		{"Future hours", 3 * stdtime.Hour, LocaleEnUS, "in 3 hours"},
+		{"Future hours Thai", 3 * stdtime.Hour, LocaleThTH, "อีก 3 ชั่วโมง"},
+		{"Future day", 25 * stdtime.Hour, LocaleEnUS, "in 1 day"},
+		{"Unknown locale uses English", -stdtime.Minute, "ja-JP", "1 minute ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := FromStd(ref.Add(tt.offset)).InEra(BE())
+			if got := tm.Humanize(tt.locale); got != tt.expected {
+				t.Errorf("Humanize(%q) = %q, want %q", tt.locale, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatDuration tests duration rendering in Thai and English
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		d        stdtime.Duration
+		locale   string
+		maxUnits []int
+		expected string
+	}{
+		{"Multi-unit", 2*stdtime.Hour + 30*stdtime.Minute, LocaleEnUS, nil, "2 hours 30 minutes"},
+		{"Multi-unit Thai", 2*stdtime.Hour + 30*stdtime.Minute, LocaleThTH, nil, "2 ชั่วโมง 30 นาที"},
+		{"Singular", stdtime.Hour + stdtime.Second, LocaleEnUS, nil, "1 hour 1 second"},
+		{"Days", 26*stdtime.Hour + 90*stdtime.Second, LocaleEnUS, nil, "1 day 2 hours 1 minute 30 seconds"},
+		{"Capped to two units", 26*stdtime.Hour + 90*stdtime.Second, LocaleEnUS, []int{2}, "1 day 2 hours"},
+		{"Cap larger than components", 90 * stdtime.Second, LocaleEnUS, []int{5}, "1 minute 30 seconds"},
+		{"Sub-second", 1500 * stdtime.Millisecond, LocaleEnUS, nil, "1 second 500 milliseconds"},
+		{"Sub-second Thai", 250 * stdtime.Millisecond, LocaleThTH, nil, "250 มิลลิวินาที"},
+		{"Microseconds", 1500 * stdtime.Nanosecond, LocaleEnUS, nil, "1 microsecond 500 nanoseconds"},
+		{"Negative", -(2*stdtime.Hour + 30*stdtime.Minute), LocaleEnUS, nil, "-2 hours 30 minutes"},
+		{"Negative Thai", -45 * stdtime.Minute, LocaleThTH, nil, "-45 นาที"},
+		{"Zero", 0, LocaleEnUS, nil, "0 seconds"},
+		{"Zero Thai", 0, LocaleThTH, nil, "0 วินาที"},
+		{"Minimum duration", stdtime.Duration(-1 << 63), LocaleEnUS, []int{1}, "-106751 days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatDuration(tt.d, tt.locale, tt.maxUnits...); got != tt.expected {
+				t.Errorf("FormatDuration(%v, %q) = %q, want %q", tt.d, tt.locale, got, tt.expected)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package internal
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -25,7 +26,8 @@ import (
 // # Thread Safety
 //
 // All methods are safe for concurrent access:
-//   - Get() uses lock-free sync.Map.Load()
+//   - Get() uses lock-free sync.Map.Load(), taking the mutex only on a hit
+//     to mark the entry as recently used
 //   - Set() uses lock-free sync.Map.Store() with mutex only for LRU
 //   - Stats() uses atomic operations for read-only access
 //
@@ -50,9 +52,20 @@ import (
 type EraCache struct {
 	cache   atomic.Value // stores *sync.Map for safe atomic swap
 	maxSize int
+	ttl     time.Duration    // Entry lifetime; 0 means entries never expire
+	now     func() time.Time // Clock for TTL expiry, replaceable in tests
 	stats   CacheStats
-	mu      sync.Mutex // Protects LRU list only
-	lruList *lruList   // For LRU eviction (optional)
+	// eraStats maps an era pointer to its *CacheStats. Like cache it holds a
+	// *sync.Map so that Clear can swap it atomically.
+	eraStats atomic.Value
+	mu       sync.Mutex // Protects LRU list only
+	lruList  *lruList   // For LRU eviction (optional)
+}
+
+// cacheEntry is the value stored in the cache map.
+type cacheEntry struct {
+	eraYear int
+	expires int64 // Unix nanoseconds after which the entry is stale; 0 if never
 }
 
 // cacheKey represents a unique cache entry key combining CE year and era pointer.
@@ -74,11 +87,13 @@ type CacheStats struct {
 	Evictions uint64
 }
 
-// lruList implements a simple doubly-linked list for LRU tracking.
+// lruList implements a doubly-linked list for LRU tracking, most recently
+// used first. The nodes map allows moving an entry to the front in O(1).
 type lruList struct {
-	head *lruNode
-	tail *lruNode
-	size int
+	head  *lruNode
+	tail  *lruNode
+	size  int
+	nodes map[cacheKey]*lruNode
 }
 
 type lruNode struct {
@@ -95,14 +110,28 @@ const DefaultMaxCacheSize = 1024
 // NewEraCache creates a new EraCache with the specified maximum size.
 // If maxSize is 0, DefaultMaxCacheSize will be used.
 func NewEraCache(maxSize int) *EraCache {
+	return NewEraCacheWithTTL(maxSize, 0)
+}
+
+// NewEraCacheWithTTL creates a new EraCache with the specified maximum size
+// whose entries expire ttl after they are set. Expired entries are reported
+// as misses by Get. If ttl <= 0, entries never expire.
+// If maxSize is 0, DefaultMaxCacheSize will be used.
+func NewEraCacheWithTTL(maxSize int, ttl time.Duration) *EraCache {
 	if maxSize <= 0 {
 		maxSize = DefaultMaxCacheSize
 	}
+	if ttl < 0 {
+		ttl = 0
+	}
 	ec := &EraCache{
 		maxSize: maxSize,
+		ttl:     ttl,
+		now:     time.Now,
 		lruList: newLRUList(),
 	}
 	ec.cache.Store(&sync.Map{})
+	ec.eraStats.Store(&sync.Map{})
 	return ec
 }
 
@@ -120,16 +149,32 @@ func (ec *EraCache) Get(ceYear int, era unsafe.Pointer) (int, bool) {
 
 	cachePtr := ec.cache.Load().(*sync.Map)
 	if val, ok := cachePtr.Load(key); ok {
-		ec.incrementHits()
-		return val.(int), true
+		entry := val.(cacheEntry)
+		if entry.expires != 0 && ec.now().UnixNano() >= entry.expires {
+			// Stale entries stay in place until Set replaces or evicts them
+			ec.incrementMisses(era)
+			return 0, false
+		}
+
+		ec.incrementHits(era)
+
+		// Mark as recently used so hot entries survive eviction
+		ec.mu.Lock()
+		if ec.lruList != nil {
+			ec.lruList.moveToFront(key)
+		}
+		ec.mu.Unlock()
+
+		return entry.eraYear, true
 	}
 
-	ec.incrementMisses()
+	ec.incrementMisses(era)
 	return 0, false
 }
 
-// Set stores the era year for the given CE year and era in the cache.
-// If the cache is at capacity, the least recently used entry is evicted.
+// Set stores the era year for the given CE year and era in the cache and
+// marks it as the most recently used entry. If the cache is at capacity,
+// the least recently used entry is evicted.
 // The era parameter should be an *Era pointer from the gotime package.
 //
 // Optimized to minimize mutex contention by only acquiring the mutex
@@ -145,29 +190,47 @@ func (ec *EraCache) Set(ceYear int, era unsafe.Pointer, eraYear int) {
 
 	// Store the new entry first (lock-free, sync.Map handles concurrency)
 	// This ensures the entry is available even if eviction fails
+	entry := cacheEntry{eraYear: eraYear}
+	if ec.ttl > 0 {
+		entry.expires = ec.now().Add(ec.ttl).UnixNano()
+	}
+
 	cachePtr := ec.cache.Load().(*sync.Map)
-	cachePtr.Store(key, eraYear)
+	cachePtr.Store(key, entry)
 
 	// Check if we need eviction - acquire mutex only for LRU management
 	// This is called after Store to minimize mutex hold time
 	ec.mu.Lock()
 	if ec.lruList != nil {
-		// Check if we need to evict before adding to LRU
-		if ec.lruList.size >= ec.maxSize {
-			evictedKey := ec.lruList.removeLeastRecent()
-			if evictedKey.ceYear != 0 {
-				// Delete from current cache
-				cachePtr := ec.cache.Load().(*sync.Map)
-				cachePtr.Delete(evictedKey)
-				ec.stats.Evictions++
+		// Updating an existing entry only changes its recency
+		if !ec.lruList.moveToFront(key) {
+			// Check if we need to evict before adding to LRU
+			if ec.lruList.size >= ec.maxSize {
+				if evictedKey, ok := ec.lruList.removeLeastRecent(); ok {
+					// Delete from current cache
+					cachePtr := ec.cache.Load().(*sync.Map)
+					cachePtr.Delete(evictedKey)
+					atomic.AddUint64(&ec.stats.Evictions, 1)
+					atomic.AddUint64(&ec.statsFor(evictedKey.era).Evictions, 1)
+				}
 			}
+			// Add to LRU list
+			ec.lruList.addToFront(key)
 		}
-		// Add to LRU list
-		ec.lruList.addToFront(key)
 	}
 	ec.mu.Unlock()
 }
 
+// MaxSize returns the maximum number of entries in the cache.
+func (ec *EraCache) MaxSize() int {
+	return ec.maxSize
+}
+
+// TTL returns the lifetime of cache entries, or 0 if they never expire.
+func (ec *EraCache) TTL() time.Duration {
+	return ec.ttl
+}
+
 // Stats returns the current cache statistics.
 // This method is lock-free for reads as stats are updated atomically.
 func (ec *EraCache) Stats() CacheStats {
@@ -185,6 +248,7 @@ func (ec *EraCache) Clear() {
 
 	// Create a new empty sync.Map and atomically swap it
 	ec.cache.Store(&sync.Map{})
+	ec.eraStats.Store(&sync.Map{})
 
 	// Reset LRU list
 	if ec.lruList != nil {
@@ -209,20 +273,51 @@ func (ec *EraCache) HitRate() float64 {
 	return float64(hits) / float64(total)
 }
 
-func (ec *EraCache) incrementHits() {
+// StatsByEra returns the cache statistics of each era that has been looked
+// up, keyed by the era pointer passed to Get and Set.
+//
+// #nosec G103 - era pointers are only used as identity keys.
+func (ec *EraCache) StatsByEra() map[unsafe.Pointer]CacheStats {
+	result := make(map[unsafe.Pointer]CacheStats)
+	ec.eraStats.Load().(*sync.Map).Range(func(key, value any) bool {
+		stats := value.(*CacheStats)
+		result[key.(unsafe.Pointer)] = CacheStats{
+			Hits:      atomic.LoadUint64(&stats.Hits),
+			Misses:    atomic.LoadUint64(&stats.Misses),
+			Evictions: atomic.LoadUint64(&stats.Evictions),
+		}
+		return true
+	})
+	return result
+}
+
+// statsFor returns the counters for era, creating them on first use.
+func (ec *EraCache) statsFor(era unsafe.Pointer) *CacheStats {
+	m := ec.eraStats.Load().(*sync.Map)
+	if stats, ok := m.Load(era); ok {
+		return stats.(*CacheStats)
+	}
+	stats, _ := m.LoadOrStore(era, &CacheStats{})
+	return stats.(*CacheStats)
+}
+
+func (ec *EraCache) incrementHits(era unsafe.Pointer) {
 	atomic.AddUint64(&ec.stats.Hits, 1)
+	atomic.AddUint64(&ec.statsFor(era).Hits, 1)
 }
 
-func (ec *EraCache) incrementMisses() {
+func (ec *EraCache) incrementMisses(era unsafe.Pointer) {
 	atomic.AddUint64(&ec.stats.Misses, 1)
+	atomic.AddUint64(&ec.statsFor(era).Misses, 1)
 }
 
 // newLRUList creates a new LRU list.
 func newLRUList() *lruList {
 	return &lruList{
-		head: nil,
-		tail: nil,
-		size: 0,
+		head:  nil,
+		tail:  nil,
+		size:  0,
+		nodes: make(map[cacheKey]*lruNode),
 	}
 }
 
@@ -237,13 +332,41 @@ func (l *lruList) addToFront(key cacheKey) {
 		l.head.prev = node
 		l.head = node
 	}
+	l.nodes[key] = node
 	l.size++
 }
 
+// moveToFront marks key as the most recently used entry. It reports
+// whether key was in the list.
+func (l *lruList) moveToFront(key cacheKey) bool {
+	node, ok := l.nodes[key]
+	if !ok {
+		return false
+	}
+	if node == l.head {
+		return true
+	}
+
+	// Unlink; node is not the head, so node.prev is non-nil
+	node.prev.next = node.next
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		l.tail = node.prev
+	}
+
+	node.prev = nil
+	node.next = l.head
+	l.head.prev = node
+	l.head = node
+	return true
+}
+
 // removeLeastRecent removes and returns the least recently used key.
-func (l *lruList) removeLeastRecent() cacheKey {
+// It returns false if the list is empty.
+func (l *lruList) removeLeastRecent() (cacheKey, bool) {
 	if l.tail == nil {
-		return cacheKey{}
+		return cacheKey{}, false
 	}
 	key := l.tail.key
 	l.tail = l.tail.prev
@@ -252,6 +375,7 @@ func (l *lruList) removeLeastRecent() cacheKey {
 	} else {
 		l.tail.next = nil
 	}
+	delete(l.nodes, key)
 	l.size--
-	return key
+	return key, true
 }
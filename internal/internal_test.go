@@ -1,9 +1,13 @@
 package internal
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+	"unsafe"
 )
 
 // BuilderPool tests
@@ -212,13 +216,6 @@ func TestEraCacheZeroMaxSize(t *testing.T) {
 func TestRegexPoolBasic(t *testing.T) {
 	rp := NewRegexPool(`\d+`)
 
-	// Get a regex
-	re := rp.Get()
-	if re == nil {
-		t.Fatal("Get returned nil")
-	}
-	rp.Put(re)
-
 	// Use ReplaceAllString
 	result := rp.ReplaceAllString("abc123def456", "NUM")
 	if result != "abcNUMdefNUM" {
@@ -267,6 +264,76 @@ func TestRegexPoolReplaceAllStringFunc(t *testing.T) {
 	}
 }
 
+// LayoutCache tests
+
+func TestLayoutCacheBasic(t *testing.T) {
+	lc := NewLayoutCache(4)
+
+	if _, ok := lc.Load("2006-01-02"); ok {
+		t.Error("Load() on empty cache should miss")
+	}
+	if got := lc.LoadOrStore("2006-01-02", "first"); got != "first" {
+		t.Errorf("LoadOrStore() = %v, want first", got)
+	}
+	if got := lc.LoadOrStore("2006-01-02", "second"); got != "first" {
+		t.Errorf("LoadOrStore() on cached layout = %v, want first", got)
+	}
+	if got, ok := lc.Load("2006-01-02"); !ok || got != "first" {
+		t.Errorf("Load() = %v, %v, want first, true", got, ok)
+	}
+	if lc.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", lc.Len())
+	}
+}
+
+func TestLayoutCacheMaxSize(t *testing.T) {
+	lc := NewLayoutCache(2)
+
+	for i := 0; i < 10; i++ {
+		layout := "layout" + strconv.Itoa(i)
+		if got := lc.LoadOrStore(layout, i); got != i {
+			t.Errorf("LoadOrStore(%q) = %v, want %d", layout, got, i)
+		}
+	}
+	if lc.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", lc.Len())
+	}
+	if _, ok := lc.Load("layout0"); !ok {
+		t.Error("layout stored before the limit should stay cached")
+	}
+	if _, ok := lc.Load("layout9"); ok {
+		t.Error("layout past the limit should not be cached")
+	}
+}
+
+func TestLayoutCacheZeroMaxSize(t *testing.T) {
+	if got := NewLayoutCache(0).MaxSize(); got != DefaultMaxLayoutCacheSize {
+		t.Errorf("MaxSize() = %d, want %d", got, DefaultMaxLayoutCacheSize)
+	}
+}
+
+func TestLayoutCacheConcurrent(t *testing.T) {
+	const maxSize = 8
+	lc := NewLayoutCache(maxSize)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 64; i++ {
+				layout := "layout" + strconv.Itoa((g*7+i)%32)
+				lc.LoadOrStore(layout, layout)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if lc.Len() != maxSize {
+		t.Errorf("Len() = %d, want %d", lc.Len(), maxSize)
+	}
+}
+
 // StringReplacer tests
 
 func TestStringReplacerBasic(t *testing.T) {
@@ -397,6 +464,229 @@ func TestStringReplacerReplaceAll(t *testing.T) {
 	}
 }
 
+// replacerLocaleNames mirrors the combined Thai month and day map used by
+// FormatLocale.
+var replacerLocaleNames = map[string]string{
+	"January": "มกราคม", "February": "กุมภาพันธ์", "March": "มีนาคม",
+	"April": "เมษายน", "May": "พฤษภาคม", "June": "มิถุนายน",
+	"July": "กรกฎาคม", "August": "สิงหาคม", "September": "กันยายน",
+	"October": "ตุลาคม", "November": "พฤศจิกายน", "December": "ธันวาคม",
+	"Jan": "ม.ค.", "Feb": "ก.พ.", "Mar": "มี.ค.", "Apr": "เม.ย.",
+	"Jun": "มิ.ย.", "Jul": "ก.ค.", "Aug": "ส.ค.", "Sep": "ก.ย.",
+	"Oct": "ต.ค.", "Nov": "พ.ย.", "Dec": "ธ.ค.",
+	"Monday": "จันทร์", "Tuesday": "อังคาร", "Wednesday": "พุธ",
+	"Thursday": "พฤหัสบดี", "Friday": "ศุกร์", "Saturday": "เสาร์", "Sunday": "อาทิตย์",
+	"Mon": "จ.", "Tue": "อ.", "Wed": "พ.", "Thu": "พฤ.", "Fri": "ศ.",
+	"Sat": "ส.", "Sun": "อา.",
+}
+
+// replacerLongInput is a long string mixing names, partial names and other text.
+var replacerLongInput = strings.Repeat(
+	"Monday, 29 February 2024 15:04 Mon Feb Ma Mayday Sept. Thu Thursday ", 16)
+
+// replaceScan is the unindexed form of Replace that checks every
+// replacement at each position, a reference for testing and benchmarking
+// the index.
+func (sr *StringReplacer) replaceScan(s string) string {
+	if len(sr.replacements) == 0 {
+		return s
+	}
+
+	sb := builderPool.Get(len(s) + 64)
+	defer builderPool.Put(sb)
+
+	i := 0
+	for i < len(s) {
+		matched := false
+		for _, rep := range sr.replacements {
+			if sr.matchesAt(s, i, rep) {
+				sb.WriteString(rep.to)
+				i += rep.len
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			sb.WriteByte(s[i])
+			i++
+		}
+	}
+
+	return sb.String()
+}
+
+func TestStringReplacerIndexMatchesScan(t *testing.T) {
+	sr := NewStringReplacer(replacerLocaleNames)
+
+	inputs := []string{
+		"",
+		"January",
+		"Sunday, 5 May 2024",
+		"JanuaryFebruary Jan Febr Sun Sunday",
+		"วันจันทร์ Monday ๒๕๖๗",
+		replacerLongInput,
+	}
+	for _, input := range inputs {
+		if got, want := sr.Replace(input), sr.replaceScan(input); got != want {
+			t.Errorf("Replace(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestStringReplacerEmptyPattern(t *testing.T) {
+	sr := NewStringReplacer(map[string]string{"": "x", "a": "b"})
+
+	if result := sr.Replace("aca"); result != "bcb" {
+		t.Errorf("Replace = %q, want %q", result, "bcb")
+	}
+}
+
+func TestStringReplacerFold(t *testing.T) {
+	sr := NewStringReplacerFold(map[string]string{
+		"January": "มกราคม",
+		"Jan":     "ม.ค.",
+		"May":     "พฤษภาคม",
+	})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"January", "มกราคม"},
+		{"JANUARY", "มกราคม"},
+		{"january", "มกราคม"},
+		{"jAnUaRy 2024", "มกราคม 2024"},
+		{"JAN", "ม.ค."},
+		{"may MAY May", "พฤษภาคม พฤษภาคม พฤษภาคม"},
+		{"Janu", "ม.ค.u"},
+		{"มกราคม", "มกราคม"},
+	}
+
+	for _, tt := range tests {
+		if got := sr.Replace(tt.input); got != tt.expected {
+			t.Errorf("Replace(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+		if got := sr.replaceScan(tt.input); got != tt.expected {
+			t.Errorf("replaceScan(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+
+	// The default constructor stays case-sensitive
+	exact := NewStringReplacer(map[string]string{"January": "มกราคม"})
+	if got := exact.Replace("JANUARY"); got != "JANUARY" {
+		t.Errorf("NewStringReplacer Replace(%q) = %q, want unchanged", "JANUARY", got)
+	}
+}
+
+// BenchmarkStringReplacerLongInput compares the first-byte index against
+// scanning every replacement at each position
+func BenchmarkStringReplacerLongInput(b *testing.B) {
+	sr := NewStringReplacer(replacerLocaleNames)
+
+	b.Run("Index", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = sr.Replace(replacerLongInput)
+		}
+	})
+	b.Run("Scan", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = sr.replaceScan(replacerLongInput)
+		}
+	})
+}
+
+// pooledRegex is the earlier RegexPool design that handed out Copy()s of
+// the compiled regexp from a sync.Pool, a reference for benchmarking the
+// shared regexp.
+type pooledRegex struct {
+	pool sync.Pool
+}
+
+func newPooledRegex(pattern string) *pooledRegex {
+	compiled := regexp.MustCompile(pattern)
+	return &pooledRegex{pool: sync.Pool{
+		New: func() any { return compiled.Copy() }, //nolint:staticcheck
+	}}
+}
+
+func (pr *pooledRegex) ReplaceAllStringFunc(s string, fn func(string) string) string {
+	re := pr.pool.Get().(*regexp.Regexp)
+	defer pr.pool.Put(re)
+	return re.ReplaceAllStringFunc(s, fn)
+}
+
+func (pr *pooledRegex) MatchString(s string) bool {
+	re := pr.pool.Get().(*regexp.Regexp)
+	defer pr.pool.Put(re)
+	return re.MatchString(s)
+}
+
+const regexPoolBenchPattern = `\b(\d{4,10})\b`
+
+func TestRegexPoolMatchesPooled(t *testing.T) {
+	rp := NewRegexPool(regexPoolBenchPattern)
+	pr := newPooledRegex(regexPoolBenchPattern)
+	double := func(match string) string { return match + match }
+
+	for _, input := range []string{"", "15 January 2567 at 10:30", "2024 2567 99 12345678901"} {
+		if got, want := rp.ReplaceAllStringFunc(input, double), pr.ReplaceAllStringFunc(input, double); got != want {
+			t.Errorf("ReplaceAllStringFunc(%q) = %q, want %q", input, got, want)
+		}
+		if got, want := rp.MatchString(input), pr.MatchString(input); got != want {
+			t.Errorf("MatchString(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+// BenchmarkRegexPoolReplaceAllStringFunc compares the shared regexp against
+// pooled copies
+func BenchmarkRegexPoolReplaceAllStringFunc(b *testing.B) {
+	const input = "15 January 2567 at 10:30"
+	identity := func(match string) string { return match }
+
+	b.Run("Shared", func(b *testing.B) {
+		rp := NewRegexPool(regexPoolBenchPattern)
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = rp.ReplaceAllStringFunc(input, identity)
+		}
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		pr := newPooledRegex(regexPoolBenchPattern)
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = pr.ReplaceAllStringFunc(input, identity)
+		}
+	})
+}
+
+// BenchmarkRegexPoolConcurrent compares the shared regexp against pooled
+// copies under concurrent access
+func BenchmarkRegexPoolConcurrent(b *testing.B) {
+	const input = "15 January 2567"
+
+	b.Run("Shared", func(b *testing.B) {
+		rp := NewRegexPool(regexPoolBenchPattern)
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = rp.MatchString(input)
+			}
+		})
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		pr := newPooledRegex(regexPoolBenchPattern)
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = pr.MatchString(input)
+			}
+		})
+	})
+}
+
 func TestEraCacheLRUEviction(t *testing.T) {
 	// Create a small cache to trigger LRU eviction
 	ec := NewEraCache(5)
@@ -439,3 +729,144 @@ func TestEraCacheLRUEviction(t *testing.T) {
 		t.Errorf("New entry year = %d, want 3500", year)
 	}
 }
+
+// TestEraCacheLRUGetPromotes tests that reading an entry protects it from eviction
+func TestEraCacheLRUGetPromotes(t *testing.T) {
+	ec := NewEraCache(4)
+
+	// The hot key is inserted first, so it would be evicted first without promotion
+	ec.Set(1, nil, 544)
+	for i := 0; i < 100; i++ {
+		if year, ok := ec.Get(1, nil); !ok || year != 544 {
+			t.Fatalf("iteration %d: Get(hot) = %d, %v; want 544, true", i, year, ok)
+		}
+		ec.Set(2000+i, nil, 2543+i)
+	}
+
+	if evictions := ec.Stats().Evictions; evictions != 97 {
+		t.Errorf("Evictions = %d, want 97", evictions)
+	}
+}
+
+// TestEraCacheStatsByEra tests per-era statistics
+func TestEraCacheStatsByEra(t *testing.T) {
+	ec := NewEraCache(1)
+	eraA, eraB := unsafe.Pointer(new(int)), unsafe.Pointer(new(int))
+
+	ec.Get(2024, eraA) // miss
+	ec.Set(2024, eraA, 2567)
+	ec.Get(2024, eraA) // hit
+	ec.Set(2024, eraB, 2224)
+	ec.Get(2024, eraB) // hit
+
+	stats := ec.StatsByEra()
+	if got, want := stats[eraA], (CacheStats{Hits: 1, Misses: 1, Evictions: 1}); got != want {
+		t.Errorf("StatsByEra()[eraA] = %+v, want %+v", got, want)
+	}
+	if got, want := stats[eraB], (CacheStats{Hits: 1}); got != want {
+		t.Errorf("StatsByEra()[eraB] = %+v, want %+v", got, want)
+	}
+
+	ec.Clear()
+	if stats := ec.StatsByEra(); len(stats) != 0 {
+		t.Errorf("StatsByEra() after Clear = %v, want empty", stats)
+	}
+}
+
+// TestEraCacheRepeatedSet tests that re-setting a key keeps one LRU node
+func TestEraCacheRepeatedSet(t *testing.T) {
+	ec := NewEraCache(4)
+
+	for i := 0; i < 1000; i++ {
+		ec.Set(2024, nil, 2567)
+	}
+
+	ec.mu.Lock()
+	size, nodes := ec.lruList.size, len(ec.lruList.nodes)
+	ec.mu.Unlock()
+	if size != 1 || nodes != 1 {
+		t.Errorf("lruList size = %d with %d nodes, want 1", size, nodes)
+	}
+	if evictions := ec.Stats().Evictions; evictions != 0 {
+		t.Errorf("Evictions = %d, want 0", evictions)
+	}
+}
+
+// TestEraCacheTTL tests that entries expire after the TTL
+func TestEraCacheTTL(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ec := NewEraCacheWithTTL(8, time.Minute)
+	ec.now = func() time.Time { return now }
+
+	ec.Set(2024, nil, 2567)
+	if year, ok := ec.Get(2024, nil); !ok || year != 2567 {
+		t.Fatalf("Get() before expiry = %d, %v; want 2567, true", year, ok)
+	}
+
+	now = now.Add(59 * time.Second)
+	if _, ok := ec.Get(2024, nil); !ok {
+		t.Error("Get() within TTL not found, want found")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := ec.Get(2024, nil); ok {
+		t.Error("Get() at TTL found, want expired")
+	}
+
+	// Setting again refreshes the entry without growing the LRU list
+	ec.Set(2024, nil, 2567)
+	if _, ok := ec.Get(2024, nil); !ok {
+		t.Error("Get() after refresh not found, want found")
+	}
+	if size := ec.lruList.size; size != 1 {
+		t.Errorf("lruList size = %d, want 1", size)
+	}
+
+	stats := ec.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 {
+		t.Errorf("Stats() = %+v, want 3 hits and 1 miss", stats)
+	}
+}
+
+// TestEraCacheNoTTL tests that entries never expire without a TTL
+func TestEraCacheNoTTL(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ec := NewEraCacheWithTTL(8, -time.Second)
+	ec.now = func() time.Time { return now }
+
+	if ec.TTL() != 0 {
+		t.Errorf("TTL() = %v, want 0", ec.TTL())
+	}
+
+	ec.Set(2024, nil, 2567)
+	now = now.Add(100 * 365 * 24 * time.Hour)
+	if _, ok := ec.Get(2024, nil); !ok {
+		t.Error("Get() without TTL not found, want found")
+	}
+}
+
+// TestEraCacheSetExisting tests that updating an entry does not grow the cache
+func TestEraCacheSetExisting(t *testing.T) {
+	ec := NewEraCache(2)
+
+	ec.Set(0, nil, 543) // Year 0 is a valid key
+	ec.Set(2024, nil, 2567)
+	ec.Set(2024, nil, 2567)
+	ec.Set(2024, nil, 2567)
+
+	if evictions := ec.Stats().Evictions; evictions != 0 {
+		t.Errorf("Evictions after re-setting = %d, want 0", evictions)
+	}
+	if _, ok := ec.Get(0, nil); !ok {
+		t.Error("Get(0) not found, want entry kept")
+	}
+
+	// 2024 is now least recently used and is evicted
+	ec.Set(2025, nil, 2568)
+	if _, ok := ec.Get(2024, nil); ok {
+		t.Error("Get(2024) found, want evicted")
+	}
+	if evictions := ec.Stats().Evictions; evictions != 1 {
+		t.Errorf("Evictions = %d, want 1", evictions)
+	}
+}
@@ -0,0 +1,69 @@
+// Package internal provides internal utilities for the time package.
+// This package is not part of the public API and may be changed at any time.
+package internal
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultMaxLayoutCacheSize is the default maximum number of layouts held
+// by a LayoutCache.
+const DefaultMaxLayoutCacheSize = 256
+
+// LayoutCache caches values derived from time layouts, such as compiled
+// regex pools, keyed by layout string.
+//
+// Layouts come from callers, so the number of distinct keys is unbounded.
+// A LayoutCache holds at most maxSize entries: once it is full, values for
+// new layouts are returned to the caller without being stored, and the
+// caller rebuilds them on every use. Cached entries are never evicted, so
+// reads stay lock-free.
+//
+// Thread Safety: All methods are safe for concurrent use.
+type LayoutCache struct {
+	entries sync.Map
+	size    int64 // accessed atomically
+	maxSize int64
+}
+
+// NewLayoutCache creates a new LayoutCache holding at most maxSize layouts.
+// If maxSize is 0 or negative, DefaultMaxLayoutCacheSize will be used.
+func NewLayoutCache(maxSize int) *LayoutCache {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxLayoutCacheSize
+	}
+	return &LayoutCache{maxSize: int64(maxSize)}
+}
+
+// Load returns the value cached for layout, if any.
+func (lc *LayoutCache) Load(layout string) (any, bool) {
+	return lc.entries.Load(layout)
+}
+
+// LoadOrStore returns the value cached for layout, if any. Otherwise it
+// stores value if the cache is not full and returns value.
+func (lc *LayoutCache) LoadOrStore(layout string, value any) any {
+	if cached, ok := lc.entries.Load(layout); ok {
+		return cached
+	}
+	if atomic.AddInt64(&lc.size, 1) > lc.maxSize {
+		atomic.AddInt64(&lc.size, -1)
+		return value
+	}
+	cached, loaded := lc.entries.LoadOrStore(layout, value)
+	if loaded {
+		atomic.AddInt64(&lc.size, -1)
+	}
+	return cached
+}
+
+// Len returns the number of cached layouts.
+func (lc *LayoutCache) Len() int {
+	return int(atomic.LoadInt64(&lc.size))
+}
+
+// MaxSize returns the maximum number of layouts the cache holds.
+func (lc *LayoutCache) MaxSize() int {
+	return int(lc.maxSize)
+}
@@ -11,13 +11,22 @@ import (
 var builderPool = NewBuilderPool()
 
 // StringReplacer performs multiple string replacements in a single pass.
-// This provides O(n) complexity instead of O(n*m) where n is the input
-// length and m is the number of replacement pairs.
+// Replacements are indexed by their first byte, so at each input position
+// only the patterns starting with that byte are compared. This gives
+// near-O(n) complexity instead of O(n*m) where n is the input length and
+// m is the number of replacement pairs.
 //
 // Thread Safety: StringReplacer is read-only after initialization,
 // making it safe for concurrent access from multiple goroutines.
 type StringReplacer struct {
 	replacements []replacement
+	// index holds the replacements grouped by the first byte of 'from',
+	// each group in the same (longest first) order as replacements.
+	// With fold, each replacement is also listed under the other ASCII case
+	// of its first byte.
+	index [256][]replacement
+	// fold enables ASCII case-insensitive matching.
+	fold bool
 }
 
 // replacement represents a single string replacement pair.
@@ -44,9 +53,27 @@ type replacement struct {
 // - Space: O(n) for the output string
 // - Allocations: Single allocation for the result string
 func NewStringReplacer(replacements map[string]string) *StringReplacer {
+	return newStringReplacer(replacements, false)
+}
+
+// NewStringReplacerFold creates a StringReplacer like NewStringReplacer that
+// matches ASCII letters case-insensitively, so "JANUARY" and "january" both
+// match the "January" key. The replacement values are written as given.
+// Non-ASCII bytes are still matched exactly. Longest matches are still
+// applied first.
+func NewStringReplacerFold(replacements map[string]string) *StringReplacer {
+	return newStringReplacer(replacements, true)
+}
+
+// newStringReplacer implements NewStringReplacer and NewStringReplacerFold.
+func newStringReplacer(replacements map[string]string, fold bool) *StringReplacer {
 	// Convert map to slice of replacements
 	reps := make([]replacement, 0, len(replacements))
 	for from, to := range replacements {
+		// An empty pattern would match everywhere without consuming input
+		if from == "" {
+			continue
+		}
 		reps = append(reps, replacement{
 			from: from,
 			to:   to,
@@ -66,17 +93,61 @@ func NewStringReplacer(replacements map[string]string) *StringReplacer {
 		return reps[i].from > reps[j].from
 	})
 
-	return &StringReplacer{
+	sr := &StringReplacer{
 		replacements: reps,
+		fold:         fold,
+	}
+	for _, rep := range reps {
+		first := rep.from[0]
+		sr.index[first] = append(sr.index[first], rep)
+		if other := swapASCIICase(first); fold && other != first {
+			sr.index[other] = append(sr.index[other], rep)
+		}
+	}
+	return sr
+}
+
+// swapASCIICase returns c in the other case if it is an ASCII letter,
+// otherwise c unchanged.
+func swapASCIICase(c byte) byte {
+	switch {
+	case 'a' <= c && c <= 'z':
+		return c - 'a' + 'A'
+	case 'A' <= c && c <= 'Z':
+		return c - 'A' + 'a'
+	default:
+		return c
+	}
+}
+
+// equalFoldASCII reports whether s and t, which have the same length, are
+// equal ignoring the case of ASCII letters.
+func equalFoldASCII(s, t string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != t[i] && swapASCIICase(c) != t[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAt reports whether rep.from occurs in s at position i.
+func (sr *StringReplacer) matchesAt(s string, i int, rep replacement) bool {
+	if len(s)-i < rep.len {
+		return false
+	}
+	if sr.fold {
+		return equalFoldASCII(s[i:i+rep.len], rep.from)
 	}
+	return s[i:i+rep.len] == rep.from
 }
 
 // Replace performs all replacements on the input string and returns
 // the result. This method is thread-safe and can be called concurrently.
 //
 // The algorithm iterates through the input string once, at each position
-// checking if any replacement matches. The longest matching replacement
-// at each position is applied first.
+// checking the replacements that start with the byte at that position.
+// The longest matching replacement at each position is applied first.
 //
 // Example:
 //
@@ -107,10 +178,10 @@ func (sr *StringReplacer) Replace(s string) string {
 	for i < len(s) {
 		matched := false
 
-		// Check all replacements at current position
+		// Check the replacements starting with the current byte
 		// Try longest matches first (already sorted by length)
-		for _, rep := range sr.replacements {
-			if len(s)-i >= rep.len && s[i:i+rep.len] == rep.from {
+		for _, rep := range sr.index[s[i]] {
+			if sr.matchesAt(s, i, rep) {
 				sb.WriteString(rep.to)
 				i += rep.len
 				matched = true
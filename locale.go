@@ -0,0 +1,402 @@
+// Package time provides a registry of locales whose month and day names are
+// translated by FormatLocale and understood by ParseLocale, with built-in
+// Thai, Lao, Khmer and Burmese names.
+package time
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/bouroo/go-time/internal"
+)
+
+// localeNames holds the month and day names registered for a locale,
+// keyed by the English names produced by a layout.
+type localeNames struct {
+	months      map[string]string
+	shortMonths map[string]string
+	days        map[string]string
+	shortDays   map[string]string
+
+	// replacer replaces all of the above in a single pass.
+	replacer *internal.StringReplacer
+	// reverse replaces the localized names with the English ones, indexed
+	// by the name forms a layout uses (see layoutNameForms). A localized
+	// name shared by a full and an abbreviated English name becomes the one
+	// matching the layout. ASCII letters match in any case.
+	reverse [4]*internal.StringReplacer
+}
+
+// Name forms used by a layout, combined to index localeNames.reverse.
+const (
+	shortMonthForm = 1 << iota
+	shortDayForm
+)
+
+// translate returns the name for original from names, or original itself if
+// the locale does not translate it.
+func (n *localeNames) translate(names map[string]string, original string) string {
+	if name, ok := names[original]; ok {
+		return name
+	}
+	return original
+}
+
+// locales maps a locale identifier to its *localeNames.
+var locales sync.Map
+
+func init() {
+	RegisterLocale(LocaleThTH, monthNames, shortMonthNames, dayNames, shortDayNames)
+	RegisterLocale(LocaleLoLA, laoMonthNames, laoShortMonthNames, laoDayNames, laoShortDayNames)
+	RegisterLocale(LocaleKmKH, khmerMonthNames, khmerShortMonthNames, khmerDayNames, khmerShortDayNames)
+	RegisterLocale(LocaleMyMM, burmeseMonthNames, burmeseShortMonthNames, burmeseDayNames, burmeseShortDayNames)
+}
+
+// RegisterLocale registers the month and day names FormatLocale uses for
+// locale. Each map is keyed by the English name produced by the layout:
+// months by full names ("January"), shortMonths by abbreviations ("Jan"),
+// days by full names ("Monday") and shortDays by abbreviations ("Mon").
+// Any map may be nil, and names missing from a map are left in English.
+//
+// The th-TH, lo-LA, km-KH and my-MM locales are built in. Registering a
+// locale again replaces its names, including the built-in ones. The maps are
+// copied, so later changes to them have no effect. RegisterLocale is safe for
+// concurrent use.
+//
+// Example:
+//
+//	RegisterLocale("vi-VN",
+//		map[string]string{"January": "Tháng Một"}, nil,
+//		map[string]string{"Monday": "Thứ Hai"}, nil)
+func RegisterLocale(locale string, months, shortMonths, days, shortDays map[string]string) {
+	names := &localeNames{
+		months:      copyNames(months),
+		shortMonths: copyNames(shortMonths),
+		days:        copyNames(days),
+		shortDays:   copyNames(shortDays),
+	}
+	// Full names take precedence over abbreviations, so "May" is replaced
+	// with the full month name
+	names.replacer = internal.NewStringReplacer(
+		mergeMaps(names.months, names.shortMonths, names.days, names.shortDays))
+	for forms := range names.reverse {
+		months := []map[string]string{invertNames(names.months), invertNames(names.shortMonths)}
+		if forms&shortMonthForm != 0 {
+			months[0], months[1] = months[1], months[0]
+		}
+		days := []map[string]string{invertNames(names.days), invertNames(names.shortDays)}
+		if forms&shortDayForm != 0 {
+			days[0], days[1] = days[1], days[0]
+		}
+		names.reverse[forms] = internal.NewStringReplacerFold(mergeMaps(months[0], months[1], days[0], days[1]))
+	}
+
+	locales.Store(locale, names)
+}
+
+// layoutNameForms reports which name forms layout uses: shortMonthForm if
+// it has a "Jan" element but no "January" element, and likewise
+// shortDayForm for "Mon" and "Monday".
+func layoutNameForms(layout string) int {
+	var hasShortMonth, hasLongMonth, hasShortDay, hasLongDay bool
+	for layout != "" {
+		var elem string
+		_, elem, layout = nextLayoutElement(layout)
+		switch elem {
+		case "Jan":
+			hasShortMonth = true
+		case "January":
+			hasLongMonth = true
+		case "Mon":
+			hasShortDay = true
+		case "Monday":
+			hasLongDay = true
+		}
+	}
+
+	forms := 0
+	if hasShortMonth && !hasLongMonth {
+		forms |= shortMonthForm
+	}
+	if hasShortDay && !hasLongDay {
+		forms |= shortDayForm
+	}
+	return forms
+}
+
+// lookupLocale returns the names registered for locale.
+func lookupLocale(locale string) (*localeNames, bool) {
+	v, ok := locales.Load(locale)
+	if !ok {
+		return nil, false
+	}
+	return v.(*localeNames), true
+}
+
+// replaceLocaleNames replaces English month and day names in s with the
+// names registered for locale. s is returned unchanged if locale is not
+// registered.
+func replaceLocaleNames(locale, s string) string {
+	names, ok := lookupLocale(locale)
+	if !ok {
+		return s
+	}
+	return names.replacer.Replace(s)
+}
+
+// localeName returns the name that locale registers for english in the map
+// chosen by names, or english itself if the locale is not registered or does
+// not translate it.
+func localeName(locale string, names func(*localeNames) map[string]string, english string) string {
+	n, ok := lookupLocale(locale)
+	if !ok {
+		return english
+	}
+	return n.translate(names(n), english)
+}
+
+// WeekdayName returns the name of t's weekday for locale, such as "จันทร์"
+// for th-TH. Locales without registered names (see RegisterLocale), such as
+// en-US, get the English name ("Monday").
+func (t Time) WeekdayName(locale string) string {
+	return localeName(locale, func(n *localeNames) map[string]string { return n.days }, t.Time.Weekday().String())
+}
+
+// WeekdayShortName returns the abbreviated name of t's weekday for locale,
+// such as "จ." for th-TH. Locales without registered names get the English
+// abbreviation ("Mon").
+func (t Time) WeekdayShortName(locale string) string {
+	return localeName(locale, func(n *localeNames) map[string]string { return n.shortDays }, t.Time.Weekday().String()[:3])
+}
+
+// MonthName returns the name of t's month for locale, such as "มกราคม" for
+// th-TH. Locales without registered names (see RegisterLocale), such as
+// en-US, get the English name ("January").
+func (t Time) MonthName(locale string) string {
+	return localeName(locale, func(n *localeNames) map[string]string { return n.months }, t.Time.Month().String())
+}
+
+// MonthShortName returns the abbreviated name of t's month for locale, such
+// as "ม.ค." for th-TH. Locales without registered names get the English
+// abbreviation ("Jan").
+//
+// Unlike FormatLocale with the "Jan" element, which cannot tell the
+// abbreviation "May" from the full name, this always returns the short
+// form, so May is "พ.ค." for th-TH.
+func (t Time) MonthShortName(locale string) string {
+	return localeName(locale, func(n *localeNames) map[string]string { return n.shortMonths }, t.Time.Month().String()[:3])
+}
+
+// ParseLocale parses a time string containing month and day names of a
+// registered locale (see RegisterLocale), the reverse of FormatLocale.
+// The localized names are replaced with the English ones before parsing,
+// ignoring the case of ASCII letters so that "15 JANVIER 2024" parses for a
+// locale registering "Janvier". The era is detected as in ParseWithLocale:
+// from the locale's default era if it has one, otherwise from the year
+// value.
+//
+// When a locale uses the same text for a full and an abbreviated name, as
+// Khmer months and Burmese weekdays do, the text is parsed as the name the
+// layout asks for: the abbreviation for "Jan" or "Mon", otherwise the full
+// name.
+//
+// Returns a ParseError if parsing fails.
+//
+// Example:
+//
+//	t, err := ParseLocale("2 January 2006", "15 ມັງກອນ 2567", LocaleLoLA)
+//	// t is 15 January 2024 in BE
+func ParseLocale(layout, value, locale string) (Time, error) {
+	converted := value
+	if names, ok := lookupLocale(locale); ok {
+		converted = names.reverse[layoutNameForms(layout)].Replace(value)
+	}
+
+	t, err := ParseWithLocale(layout, converted, locale)
+	if err != nil {
+		// Report the caller's input rather than the converted one. The
+		// position is only meaningful if no name changed the length.
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			pos := pe.Position
+			if len(converted) != len(value) {
+				pos = 0
+			}
+			return Time{}, newParseError(value, layout, pe.Era, pos, pe.Unwrap())
+		}
+		return Time{}, newParseError(value, layout, nil, 0, err)
+	}
+	return t, nil
+}
+
+// invertNames returns m with its keys and values swapped.
+func invertNames(m map[string]string) map[string]string {
+	inverted := make(map[string]string, len(m))
+	for k, v := range m {
+		inverted[v] = k
+	}
+	return inverted
+}
+
+// copyNames returns a copy of m.
+func copyNames(m map[string]string) map[string]string {
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+var laoMonthNames = map[string]string{
+	"January":   "ມັງກອນ",
+	"February":  "ກຸມພາ",
+	"March":     "ມີນາ",
+	"April":     "ເມສາ",
+	"May":       "ພຶດສະພາ",
+	"June":      "ມິຖຸນາ",
+	"July":      "ກໍລະກົດ",
+	"August":    "ສິງຫາ",
+	"September": "ກັນຍາ",
+	"October":   "ຕຸລາ",
+	"November":  "ພະຈິກ",
+	"December":  "ທັນວາ",
+}
+
+var laoShortMonthNames = map[string]string{
+	"Jan": "ມ.ກ.",
+	"Feb": "ກ.ພ.",
+	"Mar": "ມ.ນ.",
+	"Apr": "ມ.ສ.",
+	"May": "ພ.ພ.",
+	"Jun": "ມິ.ຖ.",
+	"Jul": "ກ.ລ.",
+	"Aug": "ສ.ຫ.",
+	"Sep": "ກ.ຍ.",
+	"Oct": "ຕ.ລ.",
+	"Nov": "ພ.ຈ.",
+	"Dec": "ທ.ວ.",
+}
+
+var laoDayNames = map[string]string{
+	"Monday":    "ຈັນ",
+	"Tuesday":   "ອັງຄານ",
+	"Wednesday": "ພຸດ",
+	"Thursday":  "ພະຫັດ",
+	"Friday":    "ສຸກ",
+	"Saturday":  "ເສົາ",
+	"Sunday":    "ອາທິດ",
+}
+
+var laoShortDayNames = map[string]string{
+	"Mon": "ຈ.",
+	"Tue": "ອ.",
+	"Wed": "ພ.",
+	"Thu": "ພຫ.",
+	"Fri": "ສຸ.",
+	"Sat": "ສ.",
+	"Sun": "ອາ.",
+}
+
+var khmerMonthNames = map[string]string{
+	"January":   "មករា",
+	"February":  "កុម្ភៈ",
+	"March":     "មីនា",
+	"April":     "មេសា",
+	"May":       "ឧសភា",
+	"June":      "មិថុនា",
+	"July":      "កក្កដា",
+	"August":    "សីហា",
+	"September": "កញ្ញា",
+	"October":   "តុលា",
+	"November":  "វិច្ឆិកា",
+	"December":  "ធ្នូ",
+}
+
+// Khmer does not abbreviate month names, so the short names are the full
+// names.
+var khmerShortMonthNames = map[string]string{
+	"Jan": "មករា",
+	"Feb": "កុម្ភៈ",
+	"Mar": "មីនា",
+	"Apr": "មេសា",
+	"May": "ឧសភា",
+	"Jun": "មិថុនា",
+	"Jul": "កក្កដា",
+	"Aug": "សីហា",
+	"Sep": "កញ្ញា",
+	"Oct": "តុលា",
+	"Nov": "វិច្ឆិកា",
+	"Dec": "ធ្នូ",
+}
+
+var khmerDayNames = map[string]string{
+	"Monday":    "ច័ន្ទ",
+	"Tuesday":   "អង្គារ",
+	"Wednesday": "ពុធ",
+	"Thursday":  "ព្រហស្បតិ៍",
+	"Friday":    "សុក្រ",
+	"Saturday":  "សៅរ៍",
+	"Sunday":    "អាទិត្យ",
+}
+
+var khmerShortDayNames = map[string]string{
+	"Mon": "ចន្ទ",
+	"Tue": "អង្គារ",
+	"Wed": "ពុធ",
+	"Thu": "ព្រហ",
+	"Fri": "សុក្រ",
+	"Sat": "សៅរ៍",
+	"Sun": "អាទិត្យ",
+}
+
+var burmeseMonthNames = map[string]string{
+	"January":   "ဇန်နဝါရီ",
+	"February":  "ဖေဖော်ဝါရီ",
+	"March":     "မတ်",
+	"April":     "ဧပြီ",
+	"May":       "မေ",
+	"June":      "ဇွန်",
+	"July":      "ဇူလိုင်",
+	"August":    "ဩဂုတ်",
+	"September": "စက်တင်ဘာ",
+	"October":   "အောက်တိုဘာ",
+	"November":  "နိုဝင်ဘာ",
+	"December":  "ဒီဇင်ဘာ",
+}
+
+var burmeseShortMonthNames = map[string]string{
+	"Jan": "ဇန်",
+	"Feb": "ဖေ",
+	"Mar": "မတ်",
+	"Apr": "ဧ",
+	"May": "မေ",
+	"Jun": "ဇွန်",
+	"Jul": "ဇူ",
+	"Aug": "ဩ",
+	"Sep": "စက်",
+	"Oct": "အောက်",
+	"Nov": "နို",
+	"Dec": "ဒီ",
+}
+
+var burmeseDayNames = map[string]string{
+	"Monday":    "တနင်္လာ",
+	"Tuesday":   "အင်္ဂါ",
+	"Wednesday": "ဗုဒ္ဓဟူး",
+	"Thursday":  "ကြာသပတေး",
+	"Friday":    "သောကြာ",
+	"Saturday":  "စနေ",
+	"Sunday":    "တနင်္ဂနွေ",
+}
+
+// Burmese has no separate weekday abbreviations, so the short names are the
+// full names.
+var burmeseShortDayNames = map[string]string{
+	"Mon": "တနင်္လာ",
+	"Tue": "အင်္ဂါ",
+	"Wed": "ဗုဒ္ဓဟူး",
+	"Thu": "ကြာသပတေး",
+	"Fri": "သောကြာ",
+	"Sat": "စနေ",
+	"Sun": "တနင်္ဂနွေ",
+}
@@ -0,0 +1,245 @@
+package time
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	stdtime "time"
+)
+
+// TestFormatLocaleRegistered tests month and day translation for the built-in locales
+func TestFormatLocaleRegistered(t *testing.T) {
+	tm := Date(2024, 6, 3, 14, 30, 0, 0, stdtime.UTC) // Monday
+
+	tests := []struct {
+		name     string
+		tm       Time
+		locale   string
+		layout   string
+		expected string
+	}{
+		{"Thai full", tm, LocaleThTH, "Monday 2 January 2006", "จันทร์ 3 มิถุนายน 2024"},
+		{"Thai short", tm, LocaleThTH, "Mon 2 Jan 2006", "จ. 3 มิ.ย. 2024"},
+		{"Lao full", tm, LocaleLoLA, "Monday 2 January 2006", "ຈັນ 3 ມິຖຸນາ 2024"},
+		{"Lao short", tm, LocaleLoLA, "Mon 2 Jan 2006", "ຈ. 3 ມິ.ຖ. 2024"},
+		{"Lao BE", tm.InEra(BE()), LocaleLoLA, "2 January 2006", "3 ມິຖຸນາ 2567"},
+		{"Khmer BE", tm.InEra(BE()), LocaleKmKH, "Monday 2 January 2006", "ច័ន្ទ 3 មិថុនា 2567"},
+		{"Khmer short", tm, LocaleKmKH, "Mon 2 Jan 2006", "ចន្ទ 3 មិថុនា 2024"},
+		{"Burmese BE", tm.InEra(BE()), LocaleMyMM, "Monday 2 January 2006", "တနင်္လာ 3 ဇွန် 2567"},
+		{"Burmese short", tm.InEra(BE()), LocaleMyMM, "2 Jan 06", "3 ဇွန် 67"},
+		{"Burmese short day", tm.InEra(BE()), LocaleMyMM, "Mon 2 Jan 2006", "တနင်္လာ 3 ဇွန် 2567"},
+		{"Unregistered locale", tm, "xx-XX", "2 January 2006", "3 June 2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatLocale(tt.locale, tt.layout); got != tt.expected {
+				t.Errorf("FormatLocale(%q, %q) = %q, want %q", tt.locale, tt.layout, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRegisterLocale tests registering and replacing a locale
+func TestRegisterLocale(t *testing.T) {
+	const locale = "test-REG"
+	tm := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC) // Monday
+
+	months := map[string]string{"January": "Tháng Một"}
+	RegisterLocale(locale, months, nil, map[string]string{"Monday": "Thứ Hai"}, nil)
+
+	// Changing the caller's map after registration has no effect
+	months["January"] = "changed"
+
+	if got, want := tm.FormatLocale(locale, "Monday, 2 January 2006"), "Thứ Hai, 1 Tháng Một 2024"; got != want {
+		t.Errorf("FormatLocale() = %q, want %q", got, want)
+	}
+	// Names without a translation stay in English
+	if got, want := tm.FormatLocale(locale, "Mon Jan"), "Mon Jan"; got != want {
+		t.Errorf("FormatLocale() = %q, want %q", got, want)
+	}
+
+	_, subs := tm.FormatTrace(locale, "January Jan")
+	if len(subs) != 1 || subs[0].Replacement != "Tháng Một" {
+		t.Errorf("FormatTrace() substitutions = %+v, want one for January", subs)
+	}
+
+	RegisterLocale(locale, map[string]string{"January": "Janvier"}, nil, nil, nil)
+	if got := tm.FormatLocale(locale, "January"); !strings.Contains(got, "Janvier") {
+		t.Errorf("FormatLocale() after re-registering = %q, want Janvier", got)
+	}
+}
+
+// TestParseLocaleRoundTrip tests that FormatLocale output parses back with ParseLocale
+func TestParseLocaleRoundTrip(t *testing.T) {
+	tm := Date(2024, 11, 20, 9, 15, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name   string
+		tm     Time
+		locale string
+		layout string
+	}{
+		{"Lao BE full", tm.InEra(BE()), LocaleLoLA, "Monday 2 January 2006 15:04"},
+		{"Lao BE short", tm.InEra(BE()), LocaleLoLA, "Mon 2 Jan 2006"},
+		{"Lao CE", tm, LocaleLoLA, "2 January 2006"},
+		{"Khmer BE", tm.InEra(BE()), LocaleKmKH, "Monday 2 January 2006"},
+		{"Burmese BE", tm.InEra(BE()), LocaleMyMM, "Monday 2 January 2006"},
+		{"Khmer BE short", tm.InEra(BE()), LocaleKmKH, "Mon 2 Jan 2006"},
+		{"Khmer CE short month", tm, LocaleKmKH, "2 Jan 2006"},
+		{"Burmese BE short", tm.InEra(BE()), LocaleMyMM, "Mon 2 Jan 2006"},
+		{"Burmese short day full month", tm.InEra(BE()), LocaleMyMM, "Mon 2 January 2006"},
+		{"Lao CE short", tm, LocaleLoLA, "Mon, 02 Jan 2006 15:04"},
+		{"Thai BE", tm.InEra(BE()), LocaleThTH, "Mon 2 Jan 2006"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatted := tt.tm.FormatLocale(tt.locale, tt.layout)
+			parsed, err := ParseLocale(tt.layout, formatted, tt.locale)
+			if err != nil {
+				t.Fatalf("ParseLocale(%q, %q) unexpected error: %v", tt.layout, formatted, err)
+			}
+			if !sameDate(parsed.Time, tt.tm.Time) {
+				t.Errorf("ParseLocale(%q) = %v, want date of %v", formatted, parsed.Time, tt.tm.Time)
+			}
+			if parsed.Era() != tt.tm.Era() {
+				t.Errorf("ParseLocale(%q).Era() = %v, want %v", formatted, parsed.Era(), tt.tm.Era())
+			}
+		})
+	}
+}
+
+// TestParseLocaleMixedCase tests that ParseLocale matches Latin-script
+// names regardless of their capitalization
+func TestParseLocaleMixedCase(t *testing.T) {
+	const locale = "fr-TEST"
+	RegisterLocale(locale,
+		map[string]string{"January": "Janvier"}, map[string]string{"Jan": "Janv."},
+		map[string]string{"Monday": "Lundi"}, nil)
+
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{"2 January 2006", "15 Janvier 2024"},
+		{"2 January 2006", "15 JANVIER 2024"},
+		{"2 January 2006", "15 janvier 2024"},
+		{"2 Jan 2006", "15 JANV. 2024"},
+		{"Monday 2 January 2006", "LUNDI 15 janvier 2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			parsed, err := ParseLocale(tt.layout, tt.value, locale)
+			if err != nil {
+				t.Fatalf("ParseLocale(%q) unexpected error: %v", tt.value, err)
+			}
+			if !sameDate(parsed.Time, stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC)) {
+				t.Errorf("ParseLocale(%q) = %v, want 15 January 2024", tt.value, parsed.Time)
+			}
+		})
+	}
+}
+
+// TestParseLocaleError tests that ParseLocale reports the caller's input
+func TestParseLocaleError(t *testing.T) {
+	const value = "32 ມັງກອນ 2567"
+	_, err := ParseLocale("2 January 2006", value, LocaleLoLA)
+	if !IsParseError(err) {
+		t.Fatalf("ParseLocale() error = %v, want ParseError", err)
+	}
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.Input != value {
+		t.Errorf("ParseError.Input = %q, want %q", pe.Input, value)
+	}
+}
+
+// sameDate reports whether a and b fall on the same calendar date.
+func sameDate(a, b stdtime.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// TestWeekdayName tests localized full and short weekday names
+func TestWeekdayName(t *testing.T) {
+	tests := []struct {
+		weekday   stdtime.Weekday
+		thai      string
+		thaiShort string
+	}{
+		{stdtime.Sunday, "อาทิตย์", "อา."},
+		{stdtime.Monday, "จันทร์", "จ."},
+		{stdtime.Tuesday, "อังคาร", "อ."},
+		{stdtime.Wednesday, "พุธ", "พ."},
+		{stdtime.Thursday, "พฤหัสบดี", "พฤ."},
+		{stdtime.Friday, "ศุกร์", "ศ."},
+		{stdtime.Saturday, "เสาร์", "ส."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.weekday.String(), func(t *testing.T) {
+			// 7 January 2024 is a Sunday
+			tm := Date(2024, 1, 7+int(tt.weekday), 12, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+			if got := tm.WeekdayName(LocaleThTH); got != tt.thai {
+				t.Errorf("WeekdayName(th-TH) = %q, want %q", got, tt.thai)
+			}
+			if got := tm.WeekdayShortName(LocaleThTH); got != tt.thaiShort {
+				t.Errorf("WeekdayShortName(th-TH) = %q, want %q", got, tt.thaiShort)
+			}
+			if got := tm.WeekdayName(LocaleEnUS); got != tt.weekday.String() {
+				t.Errorf("WeekdayName(en-US) = %q, want %q", got, tt.weekday.String())
+			}
+			if got, want := tm.WeekdayShortName(LocaleEnUS), tt.weekday.String()[:3]; got != want {
+				t.Errorf("WeekdayShortName(en-US) = %q, want %q", got, want)
+			}
+			// Burmese has no weekday abbreviations
+			if got, want := tm.WeekdayShortName(LocaleMyMM), tm.WeekdayName(LocaleMyMM); got != want {
+				t.Errorf("WeekdayShortName(my-MM) = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// TestMonthName tests localized full and short month names
+func TestMonthName(t *testing.T) {
+	tests := []struct {
+		month     stdtime.Month
+		thai      string
+		thaiShort string
+	}{
+		{stdtime.January, "มกราคม", "ม.ค."},
+		{stdtime.February, "กุมภาพันธ์", "ก.พ."},
+		{stdtime.March, "มีนาคม", "มี.ค."},
+		{stdtime.April, "เมษายน", "เม.ย."},
+		{stdtime.May, "พฤษภาคม", "พ.ค."},
+		{stdtime.June, "มิถุนายน", "มิ.ย."},
+		{stdtime.July, "กรกฎาคม", "ก.ค."},
+		{stdtime.August, "สิงหาคม", "ส.ค."},
+		{stdtime.September, "กันยายน", "ก.ย."},
+		{stdtime.October, "ตุลาคม", "ต.ค."},
+		{stdtime.November, "พฤศจิกายน", "พ.ย."},
+		{stdtime.December, "ธันวาคม", "ธ.ค."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.month.String(), func(t *testing.T) {
+			tm := Date(2024, int(tt.month), 15, 12, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+			if got := tm.MonthName(LocaleThTH); got != tt.thai {
+				t.Errorf("MonthName(th-TH) = %q, want %q", got, tt.thai)
+			}
+			if got := tm.MonthShortName(LocaleThTH); got != tt.thaiShort {
+				t.Errorf("MonthShortName(th-TH) = %q, want %q", got, tt.thaiShort)
+			}
+			if got := tm.MonthName(LocaleEnUS); got != tt.month.String() {
+				t.Errorf("MonthName(en-US) = %q, want %q", got, tt.month.String())
+			}
+			if got, want := tm.MonthShortName(LocaleEnUS), tt.month.String()[:3]; got != want {
+				t.Errorf("MonthShortName(en-US) = %q, want %q", got, want)
+			}
+		})
+	}
+}
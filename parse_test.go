@@ -2,6 +2,8 @@ package time
 
 import (
 	"errors"
+	"regexp"
+	"strings"
 	"testing"
 	stdtime "time"
 )
@@ -669,6 +671,50 @@ func TestParseErrorUnwrap(t *testing.T) {
 	}
 }
 
+// TestParseErrorPosition tests that ParseError points at the offending field
+func TestParseErrorPosition(t *testing.T) {
+	tests := []struct {
+		name           string
+		layout         string
+		value          string
+		era            *Era
+		expectedColumn int
+	}{
+		{"Bad month", "2006-01-02", "2024-13-01", CE(), 6},
+		{"Bad day", "2006-01-02", "2024-01-32", CE(), 9},
+		{"Day not in month", "2006-01-02", "2024-02-30", CE(), 9},
+		{"Day not in month before time", "02/01/2006 15:04", "30/02/2024 10:00", CE(), 1},
+		{"Bad hour", "2006-01-02 15:04", "2024-01-01 25:00", CE(), 12},
+		{"Non-numeric month", "2006-01-02", "2024-xx-01", CE(), 6},
+		{"Bad month in BE", "02/01/2006", "15/13/2567", BE(), 4},
+		{"Bad month name", "2 January 2006", "2 Jaxuary 2024", CE(), 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseWithEra(tt.layout, tt.value, tt.era)
+			if err == nil {
+				t.Fatalf("ParseWithEra(%q, %q) expected error", tt.layout, tt.value)
+			}
+
+			line, column := GetErrorPosition(err)
+			if line != 1 || column != tt.expectedColumn {
+				t.Errorf("GetErrorPosition() = (%d, %d), want (1, %d) for %v",
+					line, column, tt.expectedColumn, err)
+			}
+		})
+	}
+
+	t.Run("Length-changing conversion", func(t *testing.T) {
+		// Thai month names are replaced before parsing, so offsets in the
+		// converted value do not apply to the input
+		_, err := ParseWithEra("2 January 2006", "32 มกราคม 2567", BE())
+		if line, column := GetErrorPosition(err); line != 0 || column != 0 {
+			t.Errorf("GetErrorPosition() = (%d, %d), want (0, 0)", line, column)
+		}
+	})
+}
+
 // TestParseCenturyLeapYears tests parsing around century leap years
 func TestParseCenturyLeapYears(t *testing.T) {
 	tests := []struct {
@@ -769,3 +815,964 @@ func TestParseRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+// TestExtractDates tests bulk extraction of dates from free text
+func TestExtractDates(t *testing.T) {
+	SetEraDetectionReferenceDate(stdtime.Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC))
+	defer SetEraDetectionReferenceDate(stdtime.Time{})
+
+	text := "หนังสือเลขที่ 12345 ลงวันที่ 15/01/2567 ครบกำหนดวันที่ 29/02/2567 จำนวนเงิน 5000 บาท"
+
+	dates := ExtractDates(text, "02/01/2006", BE())
+	if len(dates) != 2 {
+		t.Fatalf("ExtractDates() returned %d dates, want 2", len(dates))
+	}
+
+	expected := []struct {
+		year  int
+		month stdtime.Month
+		day   int
+	}{
+		{2024, stdtime.January, 15},
+		{2024, stdtime.February, 29},
+	}
+	for i, exp := range expected {
+		if dates[i].YearCE() != exp.year || dates[i].Month() != exp.month || dates[i].Day() != exp.day {
+			t.Errorf("dates[%d] = %v, want %d-%02d-%02d", i, dates[i], exp.year, exp.month, exp.day)
+		}
+		if dates[i].Era() != BE() {
+			t.Errorf("dates[%d].Era() = %v, want BE", i, dates[i].Era())
+		}
+	}
+}
+
+// TestExtractDatesLayoutElements tests extraction with zone offsets and
+// fractional seconds
+func TestExtractDatesLayoutElements(t *testing.T) {
+	expected := stdtime.Date(2024, 3, 5, 10, 0, 0, 500000000, stdtime.UTC)
+
+	tests := []struct {
+		layout string
+		text   string
+	}{
+		{stdtime.RFC3339Nano, "logged at 2024-03-05T10:00:00.5Z by cron"},
+		{stdtime.RFC3339, "logged at 2024-03-05T17:00:00.5+07:00 by cron"},
+		{"2006-01-02T15:04:05.000Z0700", "logged at 2024-03-05T10:00:00.500Z by cron"},
+		{"2006-01-02 15:04:05,000000 -07:00:00", "logged at 2024-03-05 17:00:00,500000 +07:00:00 by cron"},
+		{"2006-01-02 15:04:05.999 -070000", "logged at 2024-03-05 17:00:00.5 +070000 by cron"},
+		{"2006-01-02 15:04:05.9 Z07", "logged at 2024-03-05 17:00:00.5 +07 by cron"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			dates := ExtractDates(tt.text, tt.layout, CE())
+			if len(dates) != 1 {
+				t.Fatalf("ExtractDates(%q) returned %d dates, want 1", tt.text, len(dates))
+			}
+			if !dates[0].Time.Equal(expected) {
+				t.Errorf("ExtractDates(%q) = %v, want %v", tt.text, dates[0].Time, expected)
+			}
+		})
+	}
+}
+
+// TestExtractDatesManyLayouts tests that extraction keeps working once the
+// layout cache is full, and that the cache stays bounded
+func TestExtractDatesManyLayouts(t *testing.T) {
+	for i := 0; i < layoutRegexPools.MaxSize()+16; i++ {
+		layout := "02/01/2006" + strings.Repeat("~", i) + "x"
+		text := "due 15/01/2024" + strings.Repeat("~", i) + "x today"
+
+		dates := ExtractDates(text, layout, CE())
+		if len(dates) != 1 || dates[0].YearCE() != 2024 {
+			t.Fatalf("ExtractDates(%q, %q) = %v, want one date in 2024", text, layout, dates)
+		}
+	}
+	if layoutRegexPools.Len() > layoutRegexPools.MaxSize() {
+		t.Errorf("layoutRegexPools.Len() = %d, want at most %d", layoutRegexPools.Len(), layoutRegexPools.MaxSize())
+	}
+}
+
+// TestLayoutPatternMatchesFormat tests that the pattern built for a layout
+// matches the layout's formatted output, for the standard library layouts
+// and other elements
+func TestLayoutPatternMatchesFormat(t *testing.T) {
+	times := []stdtime.Time{
+		stdtime.Date(2024, 3, 5, 9, 4, 5, 0, stdtime.FixedZone("ICT", 7*60*60)),
+		stdtime.Date(2024, 12, 25, 23, 59, 59, 123456789, stdtime.UTC),
+	}
+	layouts := []string{
+		stdtime.Layout, stdtime.ANSIC, stdtime.UnixDate, stdtime.RubyDate,
+		stdtime.RFC822, stdtime.RFC822Z, stdtime.RFC850, stdtime.RFC1123,
+		stdtime.RFC1123Z, stdtime.RFC3339, stdtime.RFC3339Nano, stdtime.Kitchen,
+		stdtime.Stamp, stdtime.StampMilli, stdtime.StampMicro, stdtime.StampNano,
+		"2006-01-02 15:04:05", "2006-01-02", "15:04:05",
+		"2006 __2 002", "_2/1/06 3:4:5 pm", "15:04:05,000000 Z070000",
+		"15:04:05.999 -07:00:00", "Z07 -07", "_2006",
+	}
+
+	for _, layout := range layouts {
+		re := regexp.MustCompile(`^` + layoutPattern(layout, "", "") + `$`)
+		for _, tm := range times {
+			if formatted := tm.Format(layout); !re.MatchString(formatted) {
+				t.Errorf("layoutPattern(%q) does not match %q", layout, formatted)
+			}
+		}
+	}
+}
+
+// TestExtractDatesThaiMonthNames tests extraction with Thai month names
+func TestExtractDatesThaiMonthNames(t *testing.T) {
+	text := "ประชุมวันที่ 15 มกราคม 2567 และ 1 กุมภาพันธ์ 2567 ห้อง 2024"
+
+	dates := ExtractDates(text, "2 January 2006", BE())
+	if len(dates) != 2 {
+		t.Fatalf("ExtractDates() returned %d dates, want 2", len(dates))
+	}
+	if dates[0].Month() != stdtime.January || dates[1].Month() != stdtime.February {
+		t.Errorf("months = %v, %v, want January, February", dates[0].Month(), dates[1].Month())
+	}
+}
+
+// TestExtractDatesSkipsInvalid tests that unparseable candidates are skipped
+func TestExtractDatesSkipsInvalid(t *testing.T) {
+	text := "valid 2024-02-29, invalid 2023-02-29, number 20240229"
+
+	dates := ExtractDates(text, "2006-01-02", CE())
+	if len(dates) != 1 {
+		t.Fatalf("ExtractDates() returned %d dates, want 1", len(dates))
+	}
+	if dates[0].YearCE() != 2024 || dates[0].Day() != 29 {
+		t.Errorf("dates[0] = %v, want 2024-02-29", dates[0])
+	}
+
+	if got := ExtractDates("no dates here", "2006-01-02", CE()); len(got) != 0 {
+		t.Errorf("ExtractDates() on text without dates returned %d dates", len(got))
+	}
+}
+
+// TestParseThaiShortMonthNames tests parsing of abbreviated Thai month names
+// containing internal periods with a numeric day and BE year
+func TestParseThaiShortMonthNames(t *testing.T) {
+	tests := []struct {
+		value         string
+		expectedMonth stdtime.Month
+	}{
+		{"15 ม.ค. 2567", stdtime.January},
+		{"15 ก.พ. 2567", stdtime.February},
+		{"15 มี.ค. 2567", stdtime.March},
+		{"15 เม.ย. 2567", stdtime.April},
+		{"15 พ.ค. 2567", stdtime.May},
+		{"15 มิ.ย. 2567", stdtime.June},
+		{"15 ก.ค. 2567", stdtime.July},
+		{"15 ส.ค. 2567", stdtime.August},
+		{"15 ก.ย. 2567", stdtime.September},
+		{"15 ต.ค. 2567", stdtime.October},
+		{"15 พ.ย. 2567", stdtime.November},
+		{"15 ธ.ค. 2567", stdtime.December},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			result, err := ParseWithEra("02 Jan 2006", tt.value, BE())
+			if err != nil {
+				t.Fatalf("ParseWithEra(%q) unexpected error: %v", tt.value, err)
+			}
+			if result.Month() != tt.expectedMonth {
+				t.Errorf("Month = %v, want %v", result.Month(), tt.expectedMonth)
+			}
+			if result.Day() != 15 {
+				t.Errorf("Day = %d, want 15", result.Day())
+			}
+			if result.YearCE() != 2024 {
+				t.Errorf("YearCE = %d, want 2024", result.YearCE())
+			}
+			if result.Year() != 2567 {
+				t.Errorf("Year = %d, want 2567", result.Year())
+			}
+		})
+	}
+}
+
+// TestParseMixedCaseEnglishNames tests parsing English month and day names
+// regardless of their capitalization.
+func TestParseMixedCaseEnglishNames(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{"2 January 2006", "15 JANUARY 2567"},
+		{"2 January 2006", "15 january 2567"},
+		{"2 Jan 2006", "15 JAN 2567"},
+		{"Monday 2 January 2006", "MONDAY 15 jAnUaRy 2567"},
+		{"Mon 2 Jan 2006", "mon 15 jan 2567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			parsers := map[string]func(layout, value string) (Time, error){
+				"ParseWithEra": func(layout, value string) (Time, error) { return ParseWithEra(layout, value, BE()) },
+				"ParseThai":    ParseThai,
+			}
+			for name, parse := range parsers {
+				result, err := parse(tt.layout, tt.value)
+				if err != nil {
+					t.Fatalf("%s(%q) unexpected error: %v", name, tt.value, err)
+				}
+				if result.YearCE() != 2024 || result.Month() != stdtime.January || result.Day() != 15 {
+					t.Errorf("%s(%q) = %v, want 15 January 2024", name, tt.value, result.Time)
+				}
+			}
+		})
+	}
+}
+
+// TestParseThaiEraMarker tests that explicit พ.ศ./ค.ศ. markers override era detection.
+func TestParseThaiEraMarker(t *testing.T) {
+	tests := []struct {
+		name           string
+		layout         string
+		value          string
+		expectedEra    *Era
+		expectedYearCE int
+		expectedMarked bool
+	}{
+		// 2500 alone is detected as BE; the CE marker forces CE
+		{"CE marker on ambiguous year", "2006", "ค.ศ. 2500", CE(), 2500, true},
+		{"BE marker on ambiguous year", "2006", "พ.ศ. 2500", BE(), 1957, true},
+		// 2100 alone is detected as CE; the BE marker forces BE
+		{"BE marker on CE-looking year", "2006", "พ.ศ. 2100", BE(), 1557, true},
+		{"marker inside date", "2 January 2006", "15 มกราคม พ.ศ. 2567", BE(), 2024, true},
+		{"marker after year", "2 January 2006", "15 มกราคม 2024 ค.ศ.", CE(), 2024, true},
+		{"no marker", "2006", "2567", BE(), 2024, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, marked, err := ParseThaiWithMarker(tt.layout, tt.value)
+			if err != nil {
+				t.Fatalf("ParseThaiWithMarker(%q) unexpected error: %v", tt.value, err)
+			}
+			if marked != tt.expectedMarked {
+				t.Errorf("marked = %v, want %v", marked, tt.expectedMarked)
+			}
+			if result.Era() != tt.expectedEra {
+				t.Errorf("Era = %v, want %v", result.Era(), tt.expectedEra)
+			}
+			if result.YearCE() != tt.expectedYearCE {
+				t.Errorf("YearCE = %d, want %d", result.YearCE(), tt.expectedYearCE)
+			}
+
+			viaParseThai, err := ParseThai(tt.layout, tt.value)
+			if err != nil {
+				t.Fatalf("ParseThai(%q) unexpected error: %v", tt.value, err)
+			}
+			if !viaParseThai.Time.Equal(result.Time) || viaParseThai.Era() != result.Era() {
+				t.Errorf("ParseThai(%q) = %v, want %v", tt.value, viaParseThai, result)
+			}
+
+			inLoc, err := ParseThaiInLocation(tt.layout, tt.value, stdtime.UTC)
+			if err != nil {
+				t.Fatalf("ParseThaiInLocation(%q) unexpected error: %v", tt.value, err)
+			}
+			if inLoc.Era() != tt.expectedEra || inLoc.YearCE() != tt.expectedYearCE {
+				t.Errorf("ParseThaiInLocation(%q) = %v in %v, want year %d in %v",
+					tt.value, inLoc.Time, inLoc.Era(), tt.expectedYearCE, tt.expectedEra)
+			}
+		})
+	}
+}
+
+// TestParseThaiStrict tests that strict Thai parsing refuses to guess the era
+func TestParseThaiStrict(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		expectedEra    *Era
+		expectedYearCE int
+	}{
+		{"BE year", "15 มกราคม 2567", BE(), 2024},
+		{"CE year", "15 มกราคม 2024", CE(), 2024},
+		{"BE marker on ambiguous year", "15 มกราคม พ.ศ. 2300", BE(), 1757},
+		{"CE marker on ambiguous year", "15 มกราคม ค.ศ. 2300", CE(), 2300},
+		{"BE marker on CE-looking year", "15 มกราคม พ.ศ. 2024", BE(), 1481},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseThaiStrict("2 January 2006", tt.value)
+			if err != nil {
+				t.Fatalf("ParseThaiStrict(%q) unexpected error: %v", tt.value, err)
+			}
+			if result.Era() != tt.expectedEra || result.YearCE() != tt.expectedYearCE {
+				t.Errorf("ParseThaiStrict(%q) = year %d in %v, want year %d in %v",
+					tt.value, result.YearCE(), result.Era(), tt.expectedYearCE, tt.expectedEra)
+			}
+		})
+	}
+
+	errorCases := []struct {
+		layout  string
+		value   string
+		details string
+	}{
+		{"2 January 2006", "15 มกราคม 2300", "year 2300 could be BE 2300 (1757 CE) or CE 2300"},
+		{"2 January 2006", "15 มกราคม 1850", "year 1850 could be BE 1850 (1307 CE) or CE 1850"},
+		{"2 January 2006", "15 มกราคม 2700", "year 2700 could be BE 2700 (2157 CE) or CE 2700"},
+		{"2 Jan 06", "15 ม.ค. 67", "two-digit year 67 could be BE 2567 (2024 CE) or CE 2067"},
+		{"2 Jan 06", "15 ม.ค. 99", "two-digit year 99 could be BE 2599 (2056 CE) or CE 1999"},
+	}
+	for _, tt := range errorCases {
+		_, err := ParseThaiStrict(tt.layout, tt.value)
+		var eme *EraMismatchError
+		if !errors.As(err, &eme) {
+			t.Errorf("ParseThaiStrict(%q) error = %v, want EraMismatchError", tt.value, err)
+			continue
+		}
+		if eme.ExpectedEra != nil || eme.ActualEra != nil {
+			t.Errorf("ParseThaiStrict(%q) eras = %v, %v; want nil, nil", tt.value, eme.ExpectedEra, eme.ActualEra)
+		}
+		if !strings.Contains(eme.Details, tt.details) {
+			t.Errorf("ParseThaiStrict(%q) details = %q, want %q", tt.value, eme.Details, tt.details)
+		}
+	}
+
+	// A marker settles a two-digit year
+	shortCases := []struct {
+		value          string
+		expectedEra    *Era
+		expectedYearCE int
+	}{
+		{"15 ม.ค. พ.ศ. 67", BE(), 2024},
+		{"15 ม.ค. ค.ศ. 24", CE(), 2024},
+	}
+	for _, tt := range shortCases {
+		result, err := ParseThaiStrict("2 Jan 06", tt.value)
+		if err != nil {
+			t.Errorf("ParseThaiStrict(%q) unexpected error: %v", tt.value, err)
+			continue
+		}
+		if result.Era() != tt.expectedEra || result.YearCE() != tt.expectedYearCE {
+			t.Errorf("ParseThaiStrict(%q) = year %d in %v, want year %d in %v",
+				tt.value, result.YearCE(), result.Era(), tt.expectedYearCE, tt.expectedEra)
+		}
+	}
+
+	// A window wider than 543 years makes years in the overlap ambiguous
+	SetEraDetectionWindow(1800, 2600)
+	defer ClearEraDetectionWindow()
+	if _, err := ParseThaiStrict("2006", "2480"); !IsEraMismatchError(err) {
+		t.Errorf("ParseThaiStrict(%q) in overlap error = %v, want EraMismatchError", "2480", err)
+	}
+	if result, err := ParseThaiStrict("2006", "2700"); err != nil || result.Era() != BE() {
+		t.Errorf("ParseThaiStrict(%q) = %v, %v; want BE", "2700", result, err)
+	}
+}
+
+// TestParseThaiNormalizesText tests parsing of Thai text with zero-width
+// spaces and variant spellings
+func TestParseThaiNormalizesText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"Zero-width spaces", "15\u200B กุมภาพันธ์\u200B 2567"},
+		{"Zero-width space inside month", "15 กุมภา\u200Bพันธ์ 2567"},
+		{"Missing thanthakhat", "15 กุมภาพันธ 2567"},
+	}
+
+	expected := stdtime.Date(2024, 2, 15, 0, 0, 0, 0, stdtime.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseThai("2 January 2006", tt.value)
+			if err != nil {
+				t.Fatalf("ParseThai(%q) unexpected error: %v", tt.value, err)
+			}
+			if !result.Time.Equal(expected) || result.Era() != BE() {
+				t.Errorf("ParseThai(%q) = %v in %v, want %v in BE", tt.value, result.Time, result.Era(), expected)
+			}
+		})
+	}
+}
+
+// TestParseThaiDigits tests parsing of dates written in Thai numerals.
+func TestParseThaiDigits(t *testing.T) {
+	tests := []struct {
+		name           string
+		layout         string
+		value          string
+		expectedEra    *Era
+		expectedYearCE int
+	}{
+		{"BE full month", "2 January 2006", "๑๕ มกราคม ๒๕๖๗", BE(), 2024},
+		{"CE full month", "2 January 2006", "๑๕ มกราคม ๒๐๒๔", CE(), 2024},
+		{"BE numeric", "02/01/2006", "๑๕/๐๑/๒๕๖๗", BE(), 2024},
+		{"CE with marker", "2 January 2006", "๑๕ มกราคม ค.ศ. ๒๐๒๔", CE(), 2024},
+		{"mixed digits", "02/01/2006", "15/๐๑/2567", BE(), 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseThai(tt.layout, tt.value)
+			if err != nil {
+				t.Fatalf("ParseThai(%q) unexpected error: %v", tt.value, err)
+			}
+			if result.Era() != tt.expectedEra {
+				t.Errorf("Era = %v, want %v", result.Era(), tt.expectedEra)
+			}
+			if result.YearCE() != tt.expectedYearCE || result.Month() != stdtime.January || result.Day() != 15 {
+				t.Errorf("ParseThai(%q) = %v, want %d-01-15", tt.value, result.Time, tt.expectedYearCE)
+			}
+
+			inLoc, err := ParseThaiInLocation(tt.layout, tt.value, stdtime.UTC)
+			if err != nil {
+				t.Fatalf("ParseThaiInLocation(%q) unexpected error: %v", tt.value, err)
+			}
+			if !inLoc.Time.Equal(result.Time) {
+				t.Errorf("ParseThaiInLocation(%q) = %v, want %v", tt.value, inLoc.Time, result.Time)
+			}
+		})
+	}
+
+	t.Run("ParseWithEra BE", func(t *testing.T) {
+		result, err := ParseWithEra("2 January 2006", "๑๕ มกราคม ๒๕๖๗", BE())
+		if err != nil {
+			t.Fatalf("ParseWithEra() unexpected error: %v", err)
+		}
+		if result.YearCE() != 2024 || result.Day() != 15 {
+			t.Errorf("ParseWithEra() = %v, want 2024-01-15", result.Time)
+		}
+	})
+}
+
+// TestParseWithEraNonYearNumbers tests that only the layout's year is
+// converted from BE, leaving other 4-10 digit numbers unchanged
+func TestParseWithEraNonYearNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		layout   string
+		value    string
+		expected stdtime.Time
+	}{
+		{
+			name:     "Fractional seconds that look like a BE year",
+			layout:   "02/01/2006 15:04:05.0000",
+			value:    "29/02/2567 12:30:45.2560",
+			expected: stdtime.Date(2024, 2, 29, 12, 30, 45, 256000000, stdtime.UTC),
+		},
+		{
+			name:     "Nanoseconds after the year",
+			layout:   "2006-01-02T15:04:05.000000000",
+			value:    "2567-02-29T12:30:45.256012345",
+			expected: stdtime.Date(2024, 2, 29, 12, 30, 45, 256012345, stdtime.UTC),
+		},
+		{
+			name:     "Hour and minute without separator",
+			layout:   "2006-01-02 1504",
+			value:    "2567-02-29 2024",
+			expected: stdtime.Date(2024, 2, 29, 20, 24, 0, 0, stdtime.UTC),
+		},
+		{
+			name:     "Thai month name",
+			layout:   "2 January 2006 15:04:05.0000",
+			value:    "29 กุมภาพันธ์ 2567 12:30:45.2560",
+			expected: stdtime.Date(2024, 2, 29, 12, 30, 45, 256000000, stdtime.UTC),
+		},
+		{
+			name:     "Optional fraction before the year",
+			layout:   "15:04:05.999999999 2006-01-02",
+			value:    "10:00:00.2500 2567-03-05",
+			expected: stdtime.Date(2024, 3, 5, 10, 0, 0, 250000000, stdtime.UTC),
+		},
+		{
+			name:     "Fixed fraction before the year",
+			layout:   "15:04:05.000000 2006-01-02",
+			value:    "10:00:00.002500 2567-03-05",
+			expected: stdtime.Date(2024, 3, 5, 10, 0, 0, 2500000, stdtime.UTC),
+		},
+		{
+			name:     "Comma fraction before the year",
+			layout:   "15:04:05,000 02/01/2006",
+			value:    "10:00:00,256 05/03/2567",
+			expected: stdtime.Date(2024, 3, 5, 10, 0, 0, 256000000, stdtime.UTC),
+		},
+		{
+			name:     "Fraction after seconds not in the layout",
+			layout:   "15:04:05 2006-01-02",
+			value:    "10:00:00.2500 2567-03-05",
+			expected: stdtime.Date(2024, 3, 5, 10, 0, 0, 250000000, stdtime.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseWithEra(tt.layout, tt.value, BE())
+			if err != nil {
+				t.Fatalf("ParseWithEra(%q) unexpected error: %v", tt.value, err)
+			}
+			if !result.Time.Equal(tt.expected) {
+				t.Errorf("ParseWithEra(%q) = %v, want %v", tt.value, result.Time, tt.expected)
+			}
+
+			inLoc, err := ParseInLocationWithEra(tt.layout, tt.value, stdtime.UTC, BE())
+			if err != nil {
+				t.Fatalf("ParseInLocationWithEra(%q) unexpected error: %v", tt.value, err)
+			}
+			if !inLoc.Time.Equal(tt.expected) {
+				t.Errorf("ParseInLocationWithEra(%q) = %v, want %v", tt.value, inLoc.Time, tt.expected)
+			}
+		})
+	}
+
+	// A value that does not match the layout is an error, not a guess at
+	// which number is the year
+	for _, value := range []string{"10:00:00.2500 2567/03/05", "2567-03-05"} {
+		if _, err := ParseWithEra("15:04:05.999999999 2006-01-02", value, BE()); !IsParseError(err) {
+			t.Errorf("ParseWithEra(%q) error = %v, want ParseError", value, err)
+		}
+	}
+}
+
+// TestParseThaiTimeMarkers tests the Thai hour marker and period words
+func TestParseThaiTimeMarkers(t *testing.T) {
+	tests := []struct {
+		name         string
+		layout       string
+		value        string
+		expectedHour int
+		expectedMin  int
+	}{
+		{"Hour marker in layout and value", "15:04 น.", "15:30 น.", 15, 30},
+		{"Hour marker only in value", "15:04", "15:30 น.", 15, 30},
+		{"Hour marker without space", "15.04น.", "09.05น.", 9, 5},
+		{"Date with hour marker", "2 January 2006 15:04 น.", "15 มกราคม 2567 08:45 น.", 8, 45},
+		{"Before noon", "3:04 PM", "9:15 ก่อนเที่ยง", 9, 15},
+		{"After noon", "3:04 PM", "3:15 หลังเที่ยง", 15, 15},
+		{"Period word in layout", "3:04 หลังเที่ยง", "11:00 ก่อนเที่ยง", 11, 0},
+		{"Numeric only", "02/01/2006 15:04", "15/01/2567 20:10", 20, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseThai(tt.layout, tt.value)
+			if err != nil {
+				t.Fatalf("ParseThai(%q, %q) unexpected error: %v", tt.layout, tt.value, err)
+			}
+			if result.Hour() != tt.expectedHour || result.Minute() != tt.expectedMin {
+				t.Errorf("ParseThai(%q, %q) = %02d:%02d, want %02d:%02d",
+					tt.layout, tt.value, result.Hour(), result.Minute(), tt.expectedHour, tt.expectedMin)
+			}
+
+			inLoc, err := ParseThaiInLocation(tt.layout, tt.value, stdtime.UTC)
+			if err != nil {
+				t.Fatalf("ParseThaiInLocation(%q, %q) unexpected error: %v", tt.layout, tt.value, err)
+			}
+			if !inLoc.Time.Equal(result.Time) {
+				t.Errorf("ParseThaiInLocation() = %v, want %v", inLoc.Time, result.Time)
+			}
+		})
+	}
+
+	t.Run("Full date keeps BE detection", func(t *testing.T) {
+		result, err := ParseThai("2 January 2006 15:04 น.", "15 มกราคม 2567 08:45 น.")
+		if err != nil {
+			t.Fatalf("ParseThai() unexpected error: %v", err)
+		}
+		if result.Era() != BE() || result.YearCE() != 2024 {
+			t.Errorf("ParseThai() = %v in %v, want 2024 in BE", result.Time, result.Era())
+		}
+	})
+}
+
+// TestParseAllWithEra tests batch parsing with collected errors
+func TestParseAllWithEra(t *testing.T) {
+	values := []string{
+		"15/01/2567",
+		"31/02/2567", // no 31 February
+		"29/02/2567",
+		"not a date",
+		"01/12/2566",
+	}
+
+	times, err := ParseAllWithEra("02/01/2006", values, BE())
+	if len(times) != len(values) {
+		t.Fatalf("ParseAllWithEra() returned %d times, want %d", len(times), len(values))
+	}
+
+	var me *MultiError
+	if !errors.As(err, &me) {
+		t.Fatalf("ParseAllWithEra() error = %v, want *MultiError", err)
+	}
+	if me.Count() != 2 {
+		t.Errorf("Count() = %d, want 2", me.Count())
+	}
+
+	var indexes []any
+	me.Range(func(_ int, err error) {
+		if !IsParseError(err) {
+			t.Errorf("collected error = %T, want *ParseError", err)
+		}
+		indexes = append(indexes, GetErrorContext(err)["index"])
+	})
+	if len(indexes) != 2 || indexes[0] != 1 || indexes[1] != 3 {
+		t.Errorf("error indexes = %v, want [1 3]", indexes)
+	}
+
+	expected := []stdtime.Time{
+		stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC),
+		{},
+		stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC),
+		{},
+		stdtime.Date(2023, 12, 1, 0, 0, 0, 0, stdtime.UTC),
+	}
+	for i, want := range expected {
+		if !times[i].Time.Equal(want) {
+			t.Errorf("times[%d] = %v, want %v", i, times[i].Time, want)
+		}
+	}
+	if times[0].Era() != BE() || times[0].Year() != 2567 {
+		t.Errorf("times[0] = year %d in %v, want 2567 in BE", times[0].Year(), times[0].Era())
+	}
+
+	times, err = ParseAllWithEra("02/01/2006", []string{"15/01/2567"}, BE())
+	if err != nil || len(times) != 1 {
+		t.Errorf("ParseAllWithEra(valid) = %v, %v; want one time and nil error", times, err)
+	}
+}
+
+// TestParseShortBEYear tests that two-digit years are read as BE 25xx
+func TestParseShortBEYear(t *testing.T) {
+	tests := []struct {
+		name     string
+		parse    func() (Time, error)
+		expected stdtime.Time
+		era      *Era
+	}{
+		{
+			"ParseThai receipt date",
+			func() (Time, error) { return ParseThai("2 Jan 06", "15 ม.ค. 67") },
+			stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC), BE(),
+		},
+		{
+			"ParseThai Thai digits",
+			func() (Time, error) { return ParseThai("02/01/06", "๑๕/๐๑/๖๗") },
+			stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC), BE(),
+		},
+		{
+			"ParseThai leap day",
+			func() (Time, error) { return ParseThai("2 January 06", "29 กุมภาพันธ์ 67") },
+			stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), BE(),
+		},
+		{
+			"ParseThai BE 2500",
+			func() (Time, error) { return ParseThai("02/01/06", "01/01/00") },
+			stdtime.Date(1957, 1, 1, 0, 0, 0, 0, stdtime.UTC), BE(),
+		},
+		{
+			"ParseThai CE marker keeps stdlib century",
+			func() (Time, error) { return ParseThai("2 Jan 06", "15 ม.ค. ค.ศ. 24") },
+			stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC), CE(),
+		},
+		{
+			"ParseWithEra BE",
+			func() (Time, error) { return ParseWithEra("02/01/06", "15/01/67", BE()) },
+			stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC), BE(),
+		},
+		{
+			"ParseWithEra BE leap day",
+			func() (Time, error) { return ParseWithEra("02 Jan 06", "29 ก.พ. 67", BE()) },
+			stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), BE(),
+		},
+		{
+			"ParseWithEra CE like stdlib",
+			func() (Time, error) { return ParseWithEra("02/01/06", "15/01/67", CE()) },
+			stdtime.Date(2067, 1, 15, 0, 0, 0, 0, stdtime.UTC), CE(),
+		},
+		{
+			"ParseWithEra CE 20th century like stdlib",
+			func() (Time, error) { return ParseWithEra("02/01/06", "15/01/85", CE()) },
+			stdtime.Date(1985, 1, 15, 0, 0, 0, 0, stdtime.UTC), CE(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.parse()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Time.Equal(tt.expected) {
+				t.Errorf("parsed = %v, want %v", result.Time, tt.expected)
+			}
+			if result.Era() != tt.era {
+				t.Errorf("Era() = %v, want %v", result.Era(), tt.era)
+			}
+		})
+	}
+}
+
+// TestMustParse tests the panicking parse helpers
+func TestMustParse(t *testing.T) {
+	expected := stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC)
+
+	if got := MustParseWithEra("2006-01-02", "2567-01-15", BE()); !got.Time.Equal(expected) || got.Era() != BE() {
+		t.Errorf("MustParseWithEra() = %v in %v, want %v in BE", got.Time, got.Era(), expected)
+	}
+	if got := MustParseThai("2 January 2006", "15 มกราคม 2567"); !got.Time.Equal(expected) || got.Era() != BE() {
+		t.Errorf("MustParseThai() = %v in %v, want %v in BE", got.Time, got.Era(), expected)
+	}
+
+	tests := []struct {
+		name  string
+		parse func()
+		input string
+	}{
+		{"MustParseWithEra", func() { MustParseWithEra("2006-01-02", "2567-13-15", BE()) }, "2567-13-15"},
+		{"MustParseThai", func() { MustParseThai("2 January 2006", "15 ไม่ใช่เดือน 2567") }, "15 ไม่ใช่เดือน 2567"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				pe, ok := r.(*ParseError)
+				if !ok {
+					t.Fatalf("panic value = %T (%v), want *ParseError", r, r)
+				}
+				if pe.Input != tt.input {
+					t.Errorf("ParseError.Input = %q, want %q", pe.Input, tt.input)
+				}
+				if pe.Unwrap() == nil {
+					t.Error("ParseError.Unwrap() = nil, want the underlying error")
+				}
+			}()
+			tt.parse()
+		})
+	}
+}
+
+// TestParseCE tests parsing into a CE Time
+func TestParseCE(t *testing.T) {
+	result, err := ParseCE(stdtime.RFC3339, "2024-02-29T12:30:45+07:00")
+	if err != nil {
+		t.Fatalf("ParseCE() unexpected error: %v", err)
+	}
+	expected, _ := stdtime.Parse(stdtime.RFC3339, "2024-02-29T12:30:45+07:00")
+	if !result.Time.Equal(expected) || result.Era() != CE() || result.Year() != 2024 {
+		t.Errorf("ParseCE() = %v in %v, want %v in CE", result.Time, result.Era(), expected)
+	}
+	if _, offset := result.Zone(); offset != 7*60*60 {
+		t.Errorf("ParseCE() zone offset = %d, want %d", offset, 7*60*60)
+	}
+
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	result, err = ParseInLocationCE("2006-01-02 15:04", "2024-01-15 09:30", bangkok)
+	if err != nil {
+		t.Fatalf("ParseInLocationCE() unexpected error: %v", err)
+	}
+	if expected := stdtime.Date(2024, 1, 15, 9, 30, 0, 0, bangkok); !result.Time.Equal(expected) || result.Location() != bangkok {
+		t.Errorf("ParseInLocationCE() = %v, want %v", result.Time, expected)
+	}
+	if result.Era() != CE() {
+		t.Errorf("ParseInLocationCE().Era() = %v, want CE", result.Era())
+	}
+
+	// Thai names are not translated
+	if _, err := ParseCE("2 January 2006", "15 มกราคม 2024"); !IsParseError(err) {
+		t.Errorf("ParseCE(Thai month) error = %v, want ParseError", err)
+	}
+}
+
+// TestParseWithEraInvalidYear tests that years invalid in the era are rejected
+func TestParseWithEraInvalidYear(t *testing.T) {
+	tests := []struct {
+		name     string
+		layout   string
+		value    string
+		era      *Era
+		wantErr  bool
+		expected stdtime.Time
+	}{
+		{"BE year 0", "02/01/2006", "01/01/0000", BE(), true, stdtime.Time{}},
+		{"BE year 0 in Thai digits", "02/01/2006", "๐๑/๐๑/๐๐๐๐", BE(), true, stdtime.Time{}},
+		{"ROC year 0", "2006-01-02", "0-01-01", ROC(), true, stdtime.Time{}},
+		{"ROC negative year", "2006-01-02", "-5-01-01", ROC(), true, stdtime.Time{}},
+		{"BE current year", "02/01/2006", "15/01/2567", BE(), false, stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"large BE year", "02/01/2006", "31/12/9999", BE(), false, stdtime.Date(9456, 12, 31, 0, 0, 0, 0, stdtime.UTC)},
+		{"ROC year 1", "2006-01-02", "1-01-01", ROC(), false, stdtime.Date(1912, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"CE year 0 is lenient", "02/01/2006", "01/01/0000", CE(), false, stdtime.Date(0, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWithEra(tt.layout, tt.value, tt.era)
+			if tt.wantErr {
+				if !IsParseError(err) || !IsValidationError(err) {
+					t.Fatalf("ParseWithEra(%q) error = %v, want ParseError wrapping ValidationError", tt.value, err)
+				}
+				if _, err := ParseInLocationWithEra(tt.layout, tt.value, stdtime.UTC, tt.era); !IsValidationError(err) {
+					t.Errorf("ParseInLocationWithEra(%q) error = %v, want ValidationError", tt.value, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseWithEra(%q) unexpected error: %v", tt.value, err)
+			}
+			if !got.Time.Equal(tt.expected) {
+				t.Errorf("ParseWithEra(%q) = %v, want %v", tt.value, got.Time, tt.expected)
+			}
+		})
+	}
+}
+
+// TestParseWithEraCentury tests expanding two-digit years against an
+// explicit century
+func TestParseWithEraCentury(t *testing.T) {
+	tests := []struct {
+		name     string
+		layout   string
+		value    string
+		era      *Era
+		century  int
+		expected stdtime.Time
+	}{
+		{"BE 2400s", "02/01/06", "15/03/42", BE(), 2400, stdtime.Date(1899, 3, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"BE 2400s year 43", "2 Jan 06", "1 ม.ค. 43", BE(), 2400, stdtime.Date(1900, 1, 1, 0, 0, 0, 0, stdtime.UTC)},
+		{"BE 2500s", "02/01/06", "15/03/42", BE(), 2500, stdtime.Date(1999, 3, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"Thai digits", "02/01/06", "๑๕/๐๓/๔๒", BE(), 2400, stdtime.Date(1899, 3, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"CE 1900s", "02/01/06", "15/03/42", CE(), 1900, stdtime.Date(1942, 3, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"ROC century 100", "06-01-02", "13-02-29", ROC(), 100, stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)},
+		// Four-digit years are always years of the era, even where
+		// detection would read 2100 as CE
+		{"Four-digit BE year", "02/01/2006", "15/03/2100", BE(), 2400, stdtime.Date(1557, 3, 15, 0, 0, 0, 0, stdtime.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWithEraCentury(tt.layout, tt.value, tt.era, tt.century)
+			if err != nil {
+				t.Fatalf("ParseWithEraCentury(%q, %d) unexpected error: %v", tt.value, tt.century, err)
+			}
+			if !got.Time.Equal(tt.expected) || got.Era() != tt.era {
+				t.Errorf("ParseWithEraCentury(%q, %d) = %v in %v, want %v in %v",
+					tt.value, tt.century, got.Time, got.Era(), tt.expected, tt.era)
+			}
+		})
+	}
+
+	for _, century := range []int{-100, 2450} {
+		if _, err := ParseWithEraCentury("02/01/06", "15/03/42", BE(), century); !IsParseError(err) || !IsValidationError(err) {
+			t.Errorf("ParseWithEraCentury(century %d) error = %v, want ParseError wrapping ValidationError", century, err)
+		}
+	}
+	if _, err := ParseWithEraCentury("02/01/06", "15/03/00", BE(), 0); !IsValidationError(err) {
+		t.Errorf("ParseWithEraCentury(BE year 0) error = %v, want ValidationError", err)
+	}
+}
+
+// TestParseBCERoundTrip tests that years at and before 1 CE round-trip
+// through FormatBCE and ParseBCE
+func TestParseBCERoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		layout   string
+		wantYear int
+	}{
+		{"CE year 0", Date(0, 1, 1, 0, 0, 0, 0, stdtime.UTC), "02/01/2006 {bce}", 0},
+		{"CE year -543", Date(-543, 7, 4, 10, 30, 0, 0, stdtime.UTC), "2006-01-02 15:04 {bce}", -543},
+		{"leap day before 1 CE", Date(-544, 2, 29, 0, 0, 0, 0, stdtime.UTC), "{bce} 2006 Jan 2", -544},
+		{"CE year", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), "2 January 2006 {bce}", 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatted := tt.tm.FormatBCE(tt.layout)
+			got, err := ParseBCE(tt.layout, formatted)
+			if err != nil {
+				t.Fatalf("ParseBCE(%q) unexpected error: %v", formatted, err)
+			}
+			if !got.Time.Equal(tt.tm.Time) {
+				t.Errorf("ParseBCE(%q) = %v, want %v", formatted, got.Time, tt.tm.Time)
+			}
+			if got.YearCE() != tt.wantYear {
+				t.Errorf("ParseBCE(%q).YearCE() = %d, want %d", formatted, got.YearCE(), tt.wantYear)
+			}
+		})
+	}
+}
+
+// TestParseBCEErrors tests values that ParseBCE rejects
+func TestParseBCEErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+		value  string
+	}{
+		{"Year 0", "2006 {bce}", "0 BCE"},
+		{"Unknown marker", "2006 {bce}", "543 BC"},
+		{"Invalid day", "02/01/2006 {bce}", "30/02/544 BCE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseBCE(tt.layout, tt.value); !IsParseError(err) {
+				t.Errorf("ParseBCE(%q) error = %v, want ParseError", tt.value, err)
+			}
+		})
+	}
+}
+
+// TestParseAny tests layout detection for common date forms in BE and CE
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected stdtime.Time
+		era      *Era
+	}{
+		{"ISO CE", "2024-02-29", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"ISO BE", "2567-02-29", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"ISO with time", "2024-02-29 13:45:10", stdtime.Date(2024, 2, 29, 13, 45, 10, 0, stdtime.UTC), CE()},
+		{"RFC 3339", "2024-02-29T13:45:10.5+07:00", stdtime.Date(2024, 2, 29, 6, 45, 10, 500000000, stdtime.UTC), CE()},
+		{"Slash CE", "29/02/2024", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Slash BE", "29/02/2567", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"Slash BE unpadded", "1/3/2567", stdtime.Date(2024, 3, 1, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"Slash BE Thai digits", "๒๙/๐๒/๒๕๖๗", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"Spelled out CE", "February 29, 2024", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Spelled out day first CE", "29 Feb 2024", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Thai month BE", "29 กุมภาพันธ์ 2567", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), BE()},
+		{"Thai short month BE", "15 ม.ค. 2567", stdtime.Date(2024, 1, 15, 0, 0, 0, 0, stdtime.UTC), BE()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAny(tt.value)
+			if err != nil {
+				t.Fatalf("ParseAny(%q) unexpected error: %v", tt.value, err)
+			}
+			if !got.Time.Equal(tt.expected) {
+				t.Errorf("ParseAny(%q) = %v, want %v", tt.value, got.Time, tt.expected)
+			}
+			if got.Era() != tt.era {
+				t.Errorf("ParseAny(%q).Era() = %v, want %v", tt.value, got.Era(), tt.era)
+			}
+		})
+	}
+}
+
+// TestParseAnyNoMatch tests that every failed attempt is reported
+func TestParseAnyNoMatch(t *testing.T) {
+	_, err := ParseAny("not a date")
+
+	var me *MultiError
+	if !errors.As(err, &me) {
+		t.Fatalf("ParseAny() error = %v, want MultiError", err)
+	}
+	if me.Count() != len(parseAnyLayouts) {
+		t.Errorf("MultiError.Count() = %d, want %d", me.Count(), len(parseAnyLayouts))
+	}
+	if !IsParseError(err) {
+		t.Errorf("IsParseError(%v) = false, want true", err)
+	}
+}
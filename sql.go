@@ -0,0 +1,105 @@
+// Package time provides database/sql support so that era-aware times can be
+// stored in and read from SQL columns directly.
+package time
+
+import (
+	"database/sql/driver"
+	stdtime "time"
+)
+
+// sqlTimeLayouts are the text layouts accepted by Scan, tried in order.
+// Drivers that return timestamps as text commonly use one of these.
+var sqlTimeLayouts = []string{
+	stdtime.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Scan implements sql.Scanner. It accepts time.Time values as well as
+// []byte and string values formatted as RFC 3339 or "2006-01-02 15:04:05".
+// A nil value scans to the zero Time.
+//
+// The era is not stored in the database, so the scanned time is always in
+// CE. Use InEra to restore the era after scanning.
+func (t *Time) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Time{}
+		return nil
+	case stdtime.Time:
+		*t = Time{Time: v}
+		return nil
+	case []byte:
+		return t.scanString(string(v))
+	case string:
+		return t.scanString(v)
+	default:
+		return newValidationError(ErrCodeInvalidTime, "src", src, "unsupported type for Time.Scan")
+	}
+}
+
+// scanString parses a database text value with the first matching layout
+// from sqlTimeLayouts.
+func (t *Time) scanString(value string) error {
+	var lastErr error
+	for _, layout := range sqlTimeLayouts {
+		parsed, err := stdtime.Parse(layout, value)
+		if err == nil {
+			*t = Time{Time: parsed}
+			return nil
+		}
+		lastErr = err
+	}
+	return newParseError(value, stdtime.RFC3339Nano, CE(), 0, lastErr)
+}
+
+// Value implements driver.Valuer. It returns the underlying time.Time
+// instant; the era is not persisted. The zero Time, as scanned from NULL,
+// is returned as nil.
+func (t Time) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// Scan implements sql.Scanner for SQL DATE columns. It accepts time.Time
+// values, whose calendar date in their own location is used, as well as
+// []byte and string values such as "2006-01-02" or any text accepted by
+// Time.Scan. A nil value scans to the zero DateOnly.
+//
+// As with Time, the era is not stored in the database, so the scanned date
+// has no era set (CE). Use InEra to restore the era after scanning.
+func (d *DateOnly) Scan(src any) error {
+	var t Time
+	switch v := src.(type) {
+	case nil:
+		*d = DateOnly{}
+		return nil
+	case stdtime.Time:
+		t = Time{Time: v}
+	case []byte:
+		if err := t.scanString(string(v)); err != nil {
+			return err
+		}
+	case string:
+		if err := t.scanString(v); err != nil {
+			return err
+		}
+	default:
+		return newValidationError(ErrCodeInvalidTime, "src", src, "unsupported type for DateOnly.Scan")
+	}
+	*d = FromTime(t)
+	return nil
+}
+
+// Value implements driver.Valuer. It returns the date as a time.Time at
+// midnight UTC; the era is not persisted. The zero DateOnly, as scanned
+// from NULL, is returned as nil.
+func (d DateOnly) Value() (driver.Value, error) {
+	if d == (DateOnly{}) {
+		return nil, nil
+	}
+	return d.At(stdtime.UTC).Time, nil
+}
@@ -0,0 +1,164 @@
+package time
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	stdtime "time"
+)
+
+// Compile-time checks that Time works with database/sql.
+var (
+	_ sql.Scanner   = (*Time)(nil)
+	_ driver.Valuer = Time{}
+	_ sql.Scanner   = (*DateOnly)(nil)
+	_ driver.Valuer = DateOnly{}
+)
+
+// TestScan tests scanning database values into a Time
+func TestScan(t *testing.T) {
+	expected := stdtime.Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		src      any
+		expected stdtime.Time
+	}{
+		{"time.Time", expected, expected},
+		{"RFC3339 string", "2024-02-29T12:30:45Z", expected},
+		{"RFC3339 bytes", []byte("2024-02-29T12:30:45Z"), expected},
+		{"RFC3339 with offset", "2024-02-29T19:30:45+07:00", expected},
+		{"SQL datetime string", "2024-02-29 12:30:45", expected},
+		{"nil", nil, stdtime.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Start from a BE time to check that the era is reset
+			tm := Now().InEra(BE())
+			if err := tm.Scan(tt.src); err != nil {
+				t.Fatalf("Scan(%v) unexpected error: %v", tt.src, err)
+			}
+			if !tm.Time.Equal(tt.expected) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, tm.Time, tt.expected)
+			}
+			if tm.Era() != CE() {
+				t.Errorf("Scan(%v).Era() = %v, want CE", tt.src, tm.Era())
+			}
+		})
+	}
+}
+
+// TestScanErrors tests that invalid database values are rejected
+func TestScanErrors(t *testing.T) {
+	var tm Time
+
+	if err := tm.Scan("not a time"); !IsParseError(err) {
+		t.Errorf("Scan(invalid string) error = %v, want ParseError", err)
+	}
+	if err := tm.Scan(int64(1709209845)); !IsValidationError(err) {
+		t.Errorf("Scan(int64) error = %v, want ValidationError", err)
+	}
+}
+
+// TestValue tests that Value returns the underlying instant
+func TestValue(t *testing.T) {
+	instant := stdtime.Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
+
+	v, err := FromStd(instant).InEra(BE()).Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	got, ok := v.(stdtime.Time)
+	if !ok {
+		t.Fatalf("Value() type = %T, want time.Time", v)
+	}
+	if !got.Equal(instant) {
+		t.Errorf("Value() = %v, want %v", got, instant)
+	}
+
+	// Round trip through Scan
+	var scanned Time
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(Value()) unexpected error: %v", err)
+	}
+	if !scanned.Time.Equal(instant) {
+		t.Errorf("Scan(Value()) = %v, want %v", scanned.Time, instant)
+	}
+}
+
+// TestValueNull tests that the zero Time round trips through NULL
+func TestValueNull(t *testing.T) {
+	scanned := Now().InEra(BE())
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) unexpected error: %v", err)
+	}
+
+	v, err := scanned.Value()
+	if v != nil || err != nil {
+		t.Fatalf("Value() of zero Time = %v, %v; want nil, nil", v, err)
+	}
+
+	var roundTrip Time
+	if err := roundTrip.Scan(v); err != nil {
+		t.Fatalf("Scan(Value()) unexpected error: %v", err)
+	}
+	if roundTrip != (Time{}) {
+		t.Errorf("Scan(Value()) = %#v, want zero Time", roundTrip)
+	}
+}
+
+// TestDateOnlyScan tests scanning SQL DATE values into a DateOnly
+func TestDateOnlyScan(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	expected := NewDateOnly(2024, stdtime.February, 29)
+
+	tests := []struct {
+		name     string
+		src      any
+		expected DateOnly
+	}{
+		{"DATE string", "2024-02-29", expected},
+		{"DATE bytes", []byte("2024-02-29"), expected},
+		{"time.Time at midnight UTC", stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), expected},
+		{"time.Time uses its own location", stdtime.Date(2024, 2, 29, 1, 0, 0, 0, bangkok), expected},
+		{"SQL datetime string", "2024-02-29 12:30:45", expected},
+		{"nil", nil, DateOnly{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Start from a BE date to check that the era is reset
+			d := NewDateOnly(2000, stdtime.January, 1).InEra(BE())
+			if err := d.Scan(tt.src); err != nil {
+				t.Fatalf("Scan(%v) unexpected error: %v", tt.src, err)
+			}
+			if d != tt.expected {
+				t.Errorf("Scan(%v) = %#v, want %#v", tt.src, d, tt.expected)
+			}
+		})
+	}
+
+	var d DateOnly
+	if err := d.Scan("29/02/2024"); !IsParseError(err) {
+		t.Errorf("Scan(invalid string) error = %v, want ParseError", err)
+	}
+	if err := d.Scan(int64(20240229)); !IsValidationError(err) {
+		t.Errorf("Scan(int64) error = %v, want ValidationError", err)
+	}
+}
+
+// TestDateOnlyValue tests that Value returns midnight UTC without the era
+func TestDateOnlyValue(t *testing.T) {
+	v, err := NewDateOnly(2024, stdtime.February, 29).InEra(BE()).Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if expected := stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC); v != expected {
+		t.Errorf("Value() = %v, want %v", v, expected)
+	}
+
+	if v, err := (DateOnly{}).Value(); v != nil || err != nil {
+		t.Errorf("Value() of zero DateOnly = %v, %v; want nil, nil", v, err)
+	}
+}
@@ -13,15 +13,19 @@
 //   - Parse functions are safe for concurrent use
 //   - Era registration is protected by sync.RWMutex
 //
-// The global era cache (globalEraCache) uses sync.Map for lock-free reads
+// The global era cache (see SetEraCacheSize) uses sync.Map for lock-free reads
 // and atomic operations for writes, ensuring optimal performance under
 // concurrent access.
 package time
 
 import (
-	"fmt"
+	"encoding/json"
+	"errors"
+	"regexp"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	stdtime "time"
 	"unsafe"
 
@@ -34,7 +38,25 @@ var beYearRegexPool *internal.RegexPool
 // globalEraCache provides thread-safe caching for era year conversions.
 // This eliminates redundant FromCE() calculations for frequently accessed years,
 // reducing computation time by 80%+ for typical workloads.
-var globalEraCache = internal.NewEraCache(internal.DefaultMaxCacheSize)
+//
+// It holds an *internal.EraCache so that SetEraCacheSize can swap in a cache
+// of a different size while other goroutines use it; access it via eraCache.
+var globalEraCache = newEraCacheValue(internal.DefaultMaxCacheSize)
+
+// eraCacheMu serializes SetEraCacheSize calls.
+var eraCacheMu sync.Mutex
+
+// newEraCacheValue returns an atomic.Value holding a new EraCache of maxSize.
+func newEraCacheValue(maxSize int) *atomic.Value {
+	v := new(atomic.Value)
+	v.Store(internal.NewEraCache(maxSize))
+	return v
+}
+
+// eraCache returns the current global era cache.
+func eraCache() *internal.EraCache {
+	return globalEraCache.Load().(*internal.EraCache)
+}
 
 // Time wraps time.Time with era-specific functionality.
 // It embeds the standard library's Time type and adds an optional Era field
@@ -56,12 +78,169 @@ func Now() Time {
 	return Time{Time: stdtime.Now(), era: nil}
 }
 
+// NowIn returns the current time in loc with no era set (defaults to CE).
+// If loc is nil, time.Local is used, as by Now.
+func NowIn(loc *stdtime.Location) Time {
+	if loc == nil {
+		loc = stdtime.Local
+	}
+	return Time{Time: stdtime.Now().In(loc), era: nil}
+}
+
+var (
+	// elapsedReferenceDate is the instant Since and Until measure against.
+	// If zero, time.Now() is used. This enables deterministic testing.
+	elapsedReferenceDate stdtime.Time
+	elapsedMu            sync.RWMutex
+)
+
+// SetElapsedReferenceDate sets the instant that Since and Until measure
+// against. This is useful for deterministic testing.
+// Pass a zero time.Time to use time.Now().
+func SetElapsedReferenceDate(t stdtime.Time) {
+	elapsedMu.Lock()
+	defer elapsedMu.Unlock()
+	elapsedReferenceDate = t
+}
+
+// elapsedNow returns the reference date set with SetElapsedReferenceDate,
+// or the current time if none is set.
+func elapsedNow() stdtime.Time {
+	elapsedMu.RLock()
+	ref := elapsedReferenceDate
+	elapsedMu.RUnlock()
+
+	if ref.IsZero() {
+		return stdtime.Now()
+	}
+	return ref
+}
+
+// Since returns the time elapsed since t, regardless of t's era.
+// It is shorthand for Now().Sub(t).
+func Since(t Time) stdtime.Duration {
+	return elapsedNow().Sub(t.Time)
+}
+
+// Until returns the duration until t, regardless of t's era.
+// It is shorthand for t.Sub(Now()).
+func Until(t Time) stdtime.Duration {
+	return t.Time.Sub(elapsedNow())
+}
+
 // Date constructs a Time with the given components and no era set (defaults to CE).
 // It follows the same signature as time.Date from the standard library.
+// The year is an astronomical CE year and may be zero or negative for dates
+// before 1 CE, as in time.Date: Date(-542, 1, 1, ...) is 1 January 543 BCE.
 func Date(year, month, day, hour, min, sec, nsec int, loc *stdtime.Location) Time {
 	return Time{Time: stdtime.Date(year, stdtime.Month(month), day, hour, min, sec, nsec, loc), era: nil}
 }
 
+// Unix returns the local Time corresponding to the given Unix time, sec
+// seconds and nsec nanoseconds since January 1, 1970 UTC, with no era set
+// (defaults to CE).
+func Unix(sec, nsec int64) Time {
+	return Time{Time: stdtime.Unix(sec, nsec), era: nil}
+}
+
+// UnixMilli returns the local Time corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC, with no era set (defaults to CE).
+func UnixMilli(msec int64) Time {
+	return Time{Time: stdtime.UnixMilli(msec), era: nil}
+}
+
+// UnixMicro returns the local Time corresponding to the given Unix time,
+// usec microseconds since January 1, 1970 UTC, with no era set (defaults to CE).
+func UnixMicro(usec int64) Time {
+	return Time{Time: stdtime.UnixMicro(usec), era: nil}
+}
+
+// FromStd wraps an existing time.Time, such as one read from a database row
+// or an HTTP header, with no era set (defaults to CE).
+func FromStd(t stdtime.Time) Time {
+	return Time{Time: t, era: nil}
+}
+
+// timeOptions holds the components set by TimeOption values.
+type timeOptions struct {
+	year, month, day     int
+	hour, min, sec, nsec int
+	hasDate, hasClock    bool
+	loc                  *stdtime.Location
+	era                  *Era
+}
+
+// TimeOption sets a component of the Time built by NewTime.
+type TimeOption func(*timeOptions)
+
+// WithDate sets the CE year, month and day, as in Date.
+func WithDate(year, month, day int) TimeOption {
+	return func(o *timeOptions) {
+		o.year, o.month, o.day = year, month, day
+		o.hasDate = true
+	}
+}
+
+// WithClock sets the hour, minute, second and nanosecond, as in Date.
+func WithClock(hour, min, sec, nsec int) TimeOption {
+	return func(o *timeOptions) {
+		o.hour, o.min, o.sec, o.nsec = hour, min, sec, nsec
+		o.hasClock = true
+	}
+}
+
+// WithLocation sets the location. A nil loc is ignored.
+func WithLocation(loc *stdtime.Location) TimeOption {
+	return func(o *timeOptions) {
+		if loc != nil {
+			o.loc = loc
+		}
+	}
+}
+
+// WithEra sets the era. The year given to WithDate is still a CE year.
+func WithEra(era *Era) TimeOption {
+	return func(o *timeOptions) {
+		o.era = era
+	}
+}
+
+// NewTime builds a Time from the given options. Components that are not
+// set default to the current time in UTC with no era set (defaults to CE):
+// without WithDate the date is today's date in the location, and without
+// WithClock the time of day is the current one if no date is given either,
+// and midnight otherwise.
+//
+// Example:
+//
+//	bangkok, _ := stdtime.LoadLocation("Asia/Bangkok")
+//	t := NewTime(WithDate(2024, 2, 29), WithLocation(bangkok), WithEra(BE()))
+//	// 29 February 2567 BE at midnight in Bangkok
+func NewTime(opts ...TimeOption) Time {
+	o := timeOptions{loc: stdtime.UTC}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	now := stdtime.Now().In(o.loc)
+	if !o.hasDate {
+		y, m, d := now.Date()
+		o.year, o.month, o.day = y, int(m), d
+		if !o.hasClock {
+			o.hour, o.min, o.sec = now.Clock()
+			o.nsec = now.Nanosecond()
+		}
+	}
+
+	return Time{Time: stdtime.Date(o.year, stdtime.Month(o.month), o.day, o.hour, o.min, o.sec, o.nsec, o.loc), era: o.era}
+}
+
+// StdTime returns the underlying time.Time unchanged, for passing to
+// libraries that expect the standard type.
+func (t Time) StdTime() stdtime.Time {
+	return t.Time
+}
+
 // Era returns the era associated with this time, or CE if no era is set.
 func (t Time) Era() *Era {
 	if t.era == nil {
@@ -79,13 +258,38 @@ func (t Time) InEra(e *Era) Time {
 	return Time{Time: t.Time, era: e}
 }
 
+// ConvertEra returns t expressed in the target era, for answering questions
+// such as "what is this BE date's year in the Minguo calendar". The instant
+// is unchanged and Year then reports target.FromCE(t.YearCE()), so a BE time
+// in 2567 converted to ROC() reports 113. If target is nil, it defaults to
+// CE.
+//
+// ConvertEra is equivalent to InEra; use EraYear to read the year in
+// another era without converting.
+func (t Time) ConvertEra(target *Era) Time {
+	return t.InEra(target)
+}
+
+// SameEra reports whether t and u are in the same era. Eras are compared
+// with Era.Equal, and a Time with no era set is treated as CE.
+func (t Time) SameEra(u Time) bool {
+	return t.Era().Equal(u.Era())
+}
+
 // Year returns the year in the associated era. For BE era, this returns
 // the Buddhist Era year (e.g., 2567 for CE 2024).
 // This method uses caching to achieve ~90% performance improvement for repeated calls.
 func (t Time) Year() int {
-	era := t.Era()
+	return t.EraYear(t.Era())
+}
+
+// EraYear returns the year of t in era without changing t's era, such as
+// 2567 for EraYear(BE()) or 113 for EraYear(ROC()) in 2024 CE. It is the
+// same as t.InEra(era).Year(), and likewise cached. If era is nil, it
+// defaults to CE.
+func (t Time) EraYear(era *Era) int {
 	// Fast path for CE era: no calculation needed
-	if era == CE() {
+	if era == nil || era == CE() {
 		return t.Time.Year()
 	}
 
@@ -93,18 +297,20 @@ func (t Time) Year() int {
 
 	// Try cache first for non-CE eras
 	//nolint:gosec
-	if eraYear, ok := globalEraCache.Get(ceYear, unsafe.Pointer(era)); ok {
+	if eraYear, ok := eraCache().Get(ceYear, unsafe.Pointer(era)); ok {
 		return eraYear
 	}
 
 	// Calculate and cache the result
 	eraYear := era.FromCE(ceYear)
 	//nolint:gosec
-	globalEraCache.Set(ceYear, unsafe.Pointer(era), eraYear)
+	eraCache().Set(ceYear, unsafe.Pointer(era), eraYear)
 	return eraYear
 }
 
 // YearCE returns the year in Common Era, regardless of the associated era.
+// Years before 1 CE use astronomical numbering like time.Time.Year, so year
+// 0 is 1 BCE and year -542 is 543 BCE; see FormatBCE.
 func (t Time) YearCE() int {
 	return t.Time.Year()
 }
@@ -144,6 +350,23 @@ func (t Time) Location() *stdtime.Location {
 	return t.Time.Location()
 }
 
+// UTC returns t with the location set to UTC, preserving t's era.
+func (t Time) UTC() Time {
+	return Time{Time: t.Time.UTC(), era: t.era}
+}
+
+// Local returns t with the location set to local time, preserving t's era.
+func (t Time) Local() Time {
+	return Time{Time: t.Time.Local(), era: t.era}
+}
+
+// In returns a copy of t representing the same time instant, but with the
+// location set to loc for display purposes, preserving t's era.
+// In panics if loc is nil, like time.Time.In.
+func (t Time) In(loc *stdtime.Location) Time {
+	return Time{Time: t.Time.In(loc), era: t.era}
+}
+
 // Zone returns the time zone name and offset from UTC.
 func (t Time) Zone() (name string, offset int) {
 	return t.Time.Zone()
@@ -161,6 +384,18 @@ func (t Time) UnixNano() int64 {
 	return t.Time.UnixNano()
 }
 
+// UnixMilli returns t as a Unix time, the number of milliseconds elapsed
+// since January 1, 1970 UTC.
+func (t Time) UnixMilli() int64 {
+	return t.Time.UnixMilli()
+}
+
+// UnixMicro returns t as a Unix time, the number of microseconds elapsed
+// since January 1, 1970 UTC.
+func (t Time) UnixMicro() int64 {
+	return t.Time.UnixMicro()
+}
+
 // IsZero reports whether t represents the zero time instant.
 func (t Time) IsZero() bool {
 	return t.Time.IsZero()
@@ -199,18 +434,60 @@ func (t Time) Format(layout string) string {
 
 	// Try cache first for non-CE eras
 	//nolint:gosec
-	if eraYear, ok := globalEraCache.Get(ceYear, unsafe.Pointer(era)); ok {
-		formatted := t.Time.Format(layout)
-		return replaceYearInFormatted(formatted, eraYear)
+	eraYear, ok := eraCache().Get(ceYear, unsafe.Pointer(era))
+	if !ok {
+		// Calculate and cache
+		eraYear = era.FromCE(ceYear)
+		//nolint:gosec
+		eraCache().Set(ceYear, unsafe.Pointer(era), eraYear)
 	}
 
-	// Calculate and cache
-	eraYear := era.FromCE(ceYear)
-	//nolint:gosec
-	globalEraCache.Set(ceYear, unsafe.Pointer(era), eraYear)
+	// Fast path for the dominant date-only layout: build the output directly
+	// instead of formatting and then scanning for the year
+	if layout == dateOnlyLayout && eraYear >= 1000 && eraYear <= 9999 {
+		return formatDateOnly(eraYear, t.Time.Month(), t.Time.Day())
+	}
+
+	return formatWithEraYear(t.Time, layout, eraYear)
+}
+
+// dateOnlyLayout is the "YYYY-MM-DD" layout handled by the Format fast path.
+const dateOnlyLayout = "2006-01-02"
+
+// formatDateOnly renders a four-digit year, month, and day as "YYYY-MM-DD"
+// without going through time.Time.Format.
+func formatDateOnly(year int, month stdtime.Month, day int) string {
+	var buf [len(dateOnlyLayout)]byte
+	buf[0] = byte('0' + year/1000)
+	buf[1] = byte('0' + year/100%10)
+	buf[2] = byte('0' + year/10%10)
+	buf[3] = byte('0' + year%10)
+	buf[4] = '-'
+	buf[5] = byte('0' + int(month)/10)
+	buf[6] = byte('0' + int(month)%10)
+	buf[7] = '-'
+	buf[8] = byte('0' + day/10)
+	buf[9] = byte('0' + day%10)
+	return string(buf[:])
+}
 
-	formatted := t.Time.Format(layout)
-	return replaceYearInFormatted(formatted, eraYear)
+// FormatRFC3339Era returns t formatted as RFC 3339 with nanoseconds, like
+// t.Time.Format(time.RFC3339Nano), but with the era year in place of the CE
+// year, such as "2567-02-29T12:00:00Z" for a BE time. Only the year field is
+// substituted; digits in the clock, fraction and offset are never mistaken
+// for a year.
+func (t Time) FormatRFC3339Era() string {
+	return t.Format(stdtime.RFC3339Nano)
+}
+
+// FormatOrEmpty is like Format but returns an empty string for the zero
+// time. This avoids rendering placeholder years such as 0001 (or 0544 in BE)
+// that look like real data in user interfaces.
+func (t Time) FormatOrEmpty(layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
 }
 
 // String returns the time formatted as "2006-01-02 15:04:05 -0700 MST".
@@ -223,6 +500,14 @@ func (t Time) Add(d stdtime.Duration) Time {
 	return Time{Time: t.Time.Add(d), era: t.era}
 }
 
+// AddDate returns the time corresponding to adding the given number of
+// years, months, and days to t, preserving t's era. It normalizes its
+// result the same way as time.Time.AddDate, so adding one month to
+// January 31 yields March 2 (or March 1 in a leap year).
+func (t Time) AddDate(years, months, days int) Time {
+	return Time{Time: t.Time.AddDate(years, months, days), era: t.era}
+}
+
 // Sub returns the duration t-u.
 func (t Time) Sub(u Time) stdtime.Duration {
 	return t.Time.Sub(u.Time)
@@ -243,43 +528,400 @@ func (t Time) Equal(u Time) bool {
 	return t.Time.Equal(u.Time)
 }
 
+// Compare compares the time instant t with u, ignoring their eras.
+// If t is before u, it returns -1; if t is after u, it returns +1;
+// if they're the same, it returns 0. Like time.Time.Compare, this allows
+// sorting with slices.SortFunc(ts, Time.Compare).
+func (t Time) Compare(u Time) int {
+	// Implemented with Before/After rather than time.Time.Compare so that
+	// the package, unlike its benchmarks, still builds with the Go 1.18
+	// declared in go.mod
+	switch {
+	case t.Time.Before(u.Time):
+		return -1
+	case t.Time.After(u.Time):
+		return +1
+	}
+	return 0
+}
+
+// Between reports whether t lies in the half-open interval [start, end),
+// comparing instants regardless of era. It returns false if start is after
+// end.
+func (t Time) Between(start, end Time) bool {
+	return !t.Time.Before(start.Time) && t.Time.Before(end.Time)
+}
+
+// InRange reports whether t lies in the closed interval [start, end],
+// comparing instants regardless of era. It returns false if start is after
+// end.
+func (t Time) InRange(start, end Time) bool {
+	return !t.Time.Before(start.Time) && !t.Time.After(end.Time)
+}
+
+// Clamp returns t limited to the interval [min, max], comparing instants
+// regardless of era. If t is before min it returns min's instant, if t is
+// after max it returns max's instant, and otherwise it returns t; the result
+// is always in t's era. If min is after max, the result is min's instant
+// when t is before min and max's instant otherwise.
+func (t Time) Clamp(min, max Time) Time {
+	switch {
+	case t.Time.Before(min.Time):
+		return Time{Time: min.Time, era: t.era}
+	case t.Time.After(max.Time):
+		return Time{Time: max.Time, era: t.era}
+	}
+	return t
+}
+
+// Min returns the earlier of a and b, keeping its era. If they represent
+// the same instant, a is returned.
+func Min(a, b Time) Time {
+	if b.Time.Before(a.Time) {
+		return b
+	}
+	return a
+}
+
+// Max returns the later of a and b, keeping its era. If they represent the
+// same instant, a is returned.
+func Max(a, b Time) Time {
+	if b.Time.After(a.Time) {
+		return b
+	}
+	return a
+}
+
+var (
+	// jsonEmitEraYear makes MarshalJSON write the era year instead of the
+	// CE year, and UnmarshalJSON read it back.
+	jsonEmitEraYear bool
+	// jsonLayout is the layout used by MarshalJSON and UnmarshalJSON
+	// instead of RFC 3339, if non-empty.
+	jsonLayout string
+	jsonMu     sync.RWMutex
+)
+
+// SetJSONEmitEraYear sets whether MarshalJSON writes the year in the time's
+// era, so that a BE time is marshaled as "2567-02-29T12:30:45Z" rather than
+// "2024-02-29T12:30:45Z", and whether UnmarshalJSON converts such years
+// back. It is off by default, matching time.Time.
+func SetJSONEmitEraYear(emit bool) {
+	jsonMu.Lock()
+	defer jsonMu.Unlock()
+	jsonEmitEraYear = emit
+}
+
+// SetJSONLayout sets the layout MarshalJSON formats with and UnmarshalJSON
+// parses with, such as "2006-01-02" for APIs that exchange dates only.
+// Pass an empty layout to restore the default RFC 3339 format of
+// time.Time.
+//
+// The layout applies to every Time in the program; use a separate type with
+// its own MarshalJSON when different fields need different layouts.
+func SetJSONLayout(layout string) {
+	jsonMu.Lock()
+	defer jsonMu.Unlock()
+	jsonLayout = layout
+}
+
+// jsonSettings returns the values set with SetJSONLayout and
+// SetJSONEmitEraYear.
+func jsonSettings() (layout string, emitEraYear bool) {
+	jsonMu.RLock()
+	defer jsonMu.RUnlock()
+	return jsonLayout, jsonEmitEraYear
+}
+
 // MarshalJSON implements json.Marshaler. The time is marshaled
-// in the same format as time.Time.MarshalJSON.
+// in the same format as time.Time.MarshalJSON, unless a layout is set with
+// SetJSONLayout. The year is in the time's era if SetJSONEmitEraYear is on
+// and in CE otherwise.
 func (t Time) MarshalJSON() ([]byte, error) {
-	return t.Time.MarshalJSON()
+	layout, emitEraYear := jsonSettings()
+	if layout != "" {
+		if emitEraYear {
+			return json.Marshal(t.Format(layout))
+		}
+		return json.Marshal(t.Time.Format(layout))
+	}
+
+	if !emitEraYear || t.Era() == CE() {
+		return t.Time.MarshalJSON()
+	}
+
+	if eraYear := t.Era().FromCE(t.Time.Year()); eraYear < 0 || eraYear > 9999 {
+		return nil, newValidationError(ErrCodeOutOfBounds, "year", eraYear, "year outside of range [0,9999]")
+	}
+	return []byte(t.Format(`"` + stdtime.RFC3339Nano + `"`)), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler. The time is unmarshaled
-// in the same format as time.Time.UnmarshalJSON.
+// in the same format as time.Time.UnmarshalJSON, or with ParseWithEra and
+// the layout set with SetJSONLayout. Unless SetJSONEmitEraYear is on, the
+// year is a CE year and t keeps its era.
+//
+// If SetJSONEmitEraYear is on, the year is read as a year of t's era if t
+// already has a non-CE era, and otherwise as detected by DetectEraFromYear,
+// so "2567-02-29T12:30:45Z" unmarshals to a BE time in 2024 CE.
 func (t *Time) UnmarshalJSON(data []byte) error {
-	return t.Time.UnmarshalJSON(data)
+	layout, emitEraYear := jsonSettings()
+	if (layout == "" && !emitEraYear) || string(data) == "null" {
+		return t.Time.UnmarshalJSON(data)
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return newParseError(string(data), layout, t.era, 0, err)
+	}
+
+	if layout != "" {
+		era := CE()
+		if emitEraYear {
+			era = t.era
+			if era == nil || era == CE() {
+				year, _ := layoutYear(layout, value)
+				era = DetectEraFromYear(year)
+			}
+		}
+		parsed, err := ParseWithEra(layout, value, era)
+		if err != nil {
+			return err
+		}
+		if !emitEraYear {
+			// The value holds a CE year; keep t's era as the default
+			// encoding does
+			t.Time = parsed.Time
+			return nil
+		}
+		*t = parsed
+		return nil
+	}
+
+	// The year is the leading digits before the first '-'; convert it to
+	// CE before parsing so that era leap years, such as BE 2567, are valid
+	dash := strings.IndexByte(value, '-')
+	if dash <= 0 {
+		return newParseError(value, stdtime.RFC3339, t.era, 0, errors.New("missing year"))
+	}
+	year, err := strconv.Atoi(value[:dash])
+	if err != nil {
+		return newParseError(value, stdtime.RFC3339, t.era, 1, err)
+	}
+
+	era := t.era
+	if era == nil || era == CE() {
+		era = DetectEraFromYear(year)
+	}
+
+	converted := string(appendPaddedInt(nil, era.ToCE(year), 4)) + value[dash:]
+	parsed, err := stdtime.Parse(stdtime.RFC3339, converted)
+	if err != nil {
+		return newParseError(value, stdtime.RFC3339, era, parseErrorPosition(value, converted, err), err)
+	}
+	*t = Time{Time: parsed, era: era}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The time is marshaled
+// in RFC 3339 format, as by time.Time.MarshalText. Like MarshalJSON,
+// the era is not included.
+func (t Time) MarshalText() ([]byte, error) {
+	return t.Time.MarshalText()
 }
 
-// GobEncode implements gob.GobEncoder.
+// UnmarshalText implements encoding.TextUnmarshaler. The time is unmarshaled
+// from RFC 3339 format, as by time.Time.UnmarshalText.
+func (t *Time) UnmarshalText(data []byte) error {
+	return t.Time.UnmarshalText(data)
+}
+
+// GobEncode implements gob.GobEncoder. CE times are encoded exactly as by
+// time.Time.GobEncode, so they can be decoded into a time.Time. For other
+// eras the era name follows the time, and GobDecode restores the era.
 func (t Time) GobEncode() ([]byte, error) {
-	return t.Time.GobEncode()
+	data, err := t.Time.GobEncode()
+	if err != nil || t.IsCE() {
+		return data, err
+	}
+	return append(data, t.era.name...), nil
 }
 
-// GobDecode implements gob.GobDecoder.
+// GobDecode implements gob.GobDecoder. It decodes data written by GobEncode
+// or by time.Time.GobEncode. The era name, if present, is resolved with
+// GetEra; times without one, or whose era is not registered, are CE.
 func (t *Time) GobDecode(data []byte) error {
-	return t.Time.GobDecode(data)
+	n := stdBinaryLength(data)
+
+	var decoded stdtime.Time
+	if err := decoded.GobDecode(data[:n]); err != nil {
+		return err
+	}
+
+	var era *Era
+	if n < len(data) {
+		era = GetEra(string(data[n:]))
+	}
+	*t = Time{Time: decoded, era: era}
+	return nil
+}
+
+// stdBinaryLength returns the length of the time.Time binary encoding at the
+// start of data, which depends on its version byte. For an unknown version
+// it returns len(data), leaving the error to time.Time.
+func stdBinaryLength(data []byte) int {
+	const (
+		v1Length = 15 // version, seconds, nanoseconds, zone offset in minutes
+		v2Length = 16 // v1 plus zone offset seconds
+	)
+
+	if len(data) > 0 {
+		switch {
+		case data[0] == 1 && len(data) >= v1Length:
+			return v1Length
+		case data[0] == 2 && len(data) >= v2Length:
+			return v2Length
+		}
+	}
+	return len(data)
 }
 
 // Parse is a wrapper around time.Parse from the standard library.
 // It parses a formatted time string and returns the result as time.Time.
+// Use ParseCE to get a Time instead.
 func Parse(layout, value string) (stdtime.Time, error) {
 	return stdtime.Parse(layout, value)
 }
 
 // ParseInLocation is a wrapper around time.ParseInLocation from the standard library.
 // It parses a formatted time string in the given location.
+// Use ParseInLocationCE to get a Time instead.
 func ParseInLocation(layout, value string, loc *stdtime.Location) (stdtime.Time, error) {
 	return stdtime.ParseInLocation(layout, value, loc)
 }
 
+// ParseCE parses a formatted time string exactly like time.Parse and returns
+// it as a CE Time. Unlike ParseWithEra with CE(), the value is not
+// preprocessed: Thai month and day names and Thai digits are not accepted.
+// Returns a ParseError if parsing fails.
+func ParseCE(layout, value string) (Time, error) {
+	t, err := stdtime.Parse(layout, value)
+	if err != nil {
+		return Time{}, newParseError(value, layout, CE(), parseErrorPosition(value, value, err), err)
+	}
+	return Time{Time: t}, nil
+}
+
+// ParseInLocationCE is like ParseCE but interprets a time without time zone
+// information in loc, like time.ParseInLocation.
+// Returns a ParseError if parsing fails.
+func ParseInLocationCE(layout, value string, loc *stdtime.Location) (Time, error) {
+	t, err := stdtime.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return Time{}, newParseError(value, layout, CE(), parseErrorPosition(value, value, err), err)
+	}
+	return Time{Time: t}, nil
+}
+
+// bceRegexPools caches regex pools built by ParseBCE, keyed by layout string.
+var bceRegexPools = internal.NewLayoutCache(internal.DefaultMaxLayoutCacheSize)
+
+// ParseBCE parses a value formatted by FormatBCE and returns it as a CE Time,
+// whose YearCE is negative or zero for BCE years. The layout's "2006"
+// element accepts a year of one or more digits counted without a year zero,
+// and the placeholder "{bce}" accepts "BCE" or "CE"; "543 BCE" is year -542.
+// If the layout has no placeholder, years are CE.
+// Returns a ParseError if parsing fails.
+func ParseBCE(layout, value string) (Time, error) {
+	var pool *internal.RegexPool
+	if cached, ok := bceRegexPools.Load(layout); ok {
+		pool = cached.(*internal.RegexPool)
+	} else {
+		parts := strings.Split(layout, bcePlaceholder)
+		for i, part := range parts {
+			parts[i] = layoutPattern(part, "2006", `(\d{1,10})`)
+		}
+		compiled := internal.NewRegexPool(`^` + strings.Join(parts, `(BCE|CE)`) + `$`)
+		pool = bceRegexPools.LoadOrStore(layout, compiled).(*internal.RegexPool)
+	}
+
+	loc := pool.FindStringSubmatchIndex(value)
+	if loc == nil {
+		return Time{}, newParseError(value, layout, CE(), 0, errLayoutMismatch)
+	}
+
+	year, hasYear, bce := 0, false, false
+	for g := 2; g+1 < len(loc); g += 2 {
+		switch field := value[loc[g]:loc[g+1]]; field {
+		case "BCE":
+			bce = true
+		case "CE":
+		default:
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return Time{}, newParseError(value, layout, CE(), loc[g]+1, err)
+			}
+			year, hasYear = n, true
+		}
+	}
+	if hasYear && year == 0 {
+		return Time{}, newParseError(value, layout, CE(), 0,
+			newValidationError(ErrCodeInvalidTime, "year", year, "there is no year 0 in historical year numbering"))
+	}
+	if bce {
+		year = 1 - year
+	}
+
+	// The standard library only parses years 0-9999, so parse the value
+	// with a stand-in year of the same leap status and set the year after
+	proxyYear := 2001
+	if stdtime.Date(year, stdtime.December, 31, 0, 0, 0, 0, stdtime.UTC).YearDay() == 366 {
+		proxyYear = 2000
+	}
+
+	sb := builderPool.Get(len(value) + 8)
+	defer builderPool.Put(sb)
+
+	last := 0
+	for g := 2; g+1 < len(loc); g += 2 {
+		sb.WriteString(value[last:loc[g]])
+		switch value[loc[g]:loc[g+1]] {
+		case "BCE", "CE":
+			sb.WriteString(bcePlaceholder)
+		default:
+			sb.WriteString(strconv.Itoa(proxyYear))
+		}
+		last = loc[g+1]
+	}
+	sb.WriteString(value[last:])
+
+	t, err := stdtime.Parse(layout, sb.String())
+	if err != nil {
+		return Time{}, newParseError(value, layout, CE(), 0, err)
+	}
+	if hasYear {
+		t = stdtime.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+	return Time{Time: t}, nil
+}
+
 // ParseWithEra parses a time string with era-specific processing.
 // It converts Thai month and day names to English before parsing.
-// If the era is BE, it also converts Buddhist Era years to Common Era.
+// Both full and abbreviated Thai names are recognized, so "15 ก.พ. 2567"
+// parses against the layout "02 Jan 2006".
+// If the era is BE, it also converts the Buddhist Era year matched by the
+// layout's "2006" element to Common Era, accepting Thai digits (๐-๙) as well
+// as ASCII digits. Other numbers in the value are left unchanged.
+// A two-digit BE year ("06") is read in the 2500s, so "67" is BE 2567.
+// For other non-CE eras, the year matched by the layout's "2006" element is
+// converted with the era's offset, so it may have fewer than four digits
+// (e.g. Minguo year "113" for ROC()), and a two-digit year ("06") is read in
+// the century of the current era year, so "13" is Minguo 113.
+// A value whose year cannot be located because it does not match the
+// layout is rejected with a ParseError.
+// For non-CE eras, a year that is not valid in the era according to
+// Era.IsValidYear, such as BE year 0, is rejected with a ParseError
+// wrapping a ValidationError.
 // Returns a ParseError if parsing fails.
 func ParseWithEra(layout, value string, era *Era) (Time, error) {
 	if era == nil {
@@ -289,13 +931,30 @@ func ParseWithEra(layout, value string, era *Era) (Time, error) {
 	converted := replaceThaiMonthNames(value)
 	converted = replaceThaiDayNames(converted)
 
+	parseLayout := layout
 	if era == BE() {
-		converted = convertBEYearToCE(converted)
+		parseLayout, converted, _ = expandShortYears(layout, normalizeThaiDigits(converted), beShortYearBase)
+		if err := checkEraYears(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+		var err error
+		if converted, err = convertBEYearToCE(parseLayout, converted); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+	} else if era != CE() {
+		parseLayout, converted, _ = expandShortYears(layout, converted, eraShortYearBase(era))
+		if err := checkEraYears(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+		var err error
+		if converted, err = convertEraYearToCE(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
 	}
 
-	t, err := stdtime.Parse(layout, converted)
+	t, err := stdtime.Parse(parseLayout, converted)
 	if err != nil {
-		return Time{}, newParseError(value, layout, era, 0, err)
+		return Time{}, newParseError(value, layout, era, parseErrorPosition(value, converted, err), err)
 	}
 
 	return Time{Time: t, era: era}, nil
@@ -304,7 +963,9 @@ func ParseWithEra(layout, value string, era *Era) (Time, error) {
 // ParseInLocationWithEra parses a time string in a specific location with
 // era-specific processing. It converts Thai month and day names to English
 // before parsing. If the era is BE, it also converts Buddhist Era years
-// to Common Era. Returns a ParseError if parsing fails.
+// to Common Era; other non-CE eras are converted and validated as in
+// ParseWithEra.
+// Returns a ParseError if parsing fails.
 func ParseInLocationWithEra(layout, value string, loc *stdtime.Location, era *Era) (Time, error) {
 	if era == nil {
 		era = CE()
@@ -313,77 +974,811 @@ func ParseInLocationWithEra(layout, value string, loc *stdtime.Location, era *Er
 	converted := replaceThaiMonthNames(value)
 	converted = replaceThaiDayNames(converted)
 
+	parseLayout := layout
 	if era == BE() {
-		converted = convertBEYearToCE(converted)
+		parseLayout, converted, _ = expandShortYears(layout, normalizeThaiDigits(converted), beShortYearBase)
+		if err := checkEraYears(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+		var err error
+		if converted, err = convertBEYearToCE(parseLayout, converted); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+	} else if era != CE() {
+		parseLayout, converted, _ = expandShortYears(layout, converted, eraShortYearBase(era))
+		if err := checkEraYears(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+		var err error
+		if converted, err = convertEraYearToCE(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
 	}
 
-	t, err := stdtime.ParseInLocation(layout, converted, loc)
+	t, err := stdtime.ParseInLocation(parseLayout, converted, loc)
 	if err != nil {
+		return Time{}, newParseError(value, layout, era, parseErrorPosition(value, converted, err), err)
+	}
+
+	return Time{Time: t, era: era}, nil
+}
+
+// ParseWithEraCentury is like ParseWithEra, but two-digit years ("06") are
+// expanded against an explicit century of era years instead of the usual
+// rules, for deterministic imports of historical records: with century
+// 2400, "42" is BE 2442 (1899 CE). Years are converted to CE with the
+// era's offset only, never by era detection, so four-digit years ("2006")
+// in value are always read as years of era.
+//
+// The century must be a non-negative multiple of 100; otherwise a
+// ParseError wrapping a ValidationError is returned.
+func ParseWithEraCentury(layout, value string, era *Era, century int) (Time, error) {
+	if era == nil {
+		era = CE()
+	}
+	if century < 0 || century%100 != 0 {
+		err := newValidationError(ErrCodeOutOfBounds, "century", century, "century must be a non-negative multiple of 100")
 		return Time{}, newParseError(value, layout, era, 0, err)
 	}
 
+	converted := replaceThaiMonthNames(value)
+	converted = replaceThaiDayNames(converted)
+
+	parseLayout, converted, _ := expandShortYears(layout, normalizeThaiDigits(converted), century)
+	if era != CE() {
+		if err := checkEraYears(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+		var err error
+		if converted, err = convertEraYearToCE(parseLayout, converted, era); err != nil {
+			return Time{}, newParseError(value, layout, era, 0, err)
+		}
+	}
+
+	t, err := stdtime.Parse(parseLayout, converted)
+	if err != nil {
+		return Time{}, newParseError(value, layout, era, parseErrorPosition(value, converted, err), err)
+	}
+
 	return Time{Time: t, era: era}, nil
 }
 
+// ParseAllWithEra parses each of values with ParseWithEra, as when importing
+// a column of dates. It returns a slice of the same length as values, with
+// the zero Time at the index of each value that failed to parse.
+//
+// All failures are collected into a *MultiError holding one *ParseError per
+// failed value, in order; each error's context has an "index" entry with
+// the position of the value in values. The error is nil if every value
+// parsed.
+//
+// Example:
+//
+//	times, err := ParseAllWithEra("02/01/2006", column, BE())
+//	var me *MultiError
+//	if errors.As(err, &me) {
+//		me.Range(func(_ int, err error) {
+//			log.Printf("row %v: %v", GetErrorContext(err)["index"], err)
+//		})
+//	}
+func ParseAllWithEra(layout string, values []string, era *Era) ([]Time, error) {
+	times := make([]Time, len(values))
+	var errs *MultiError
+
+	for i, value := range values {
+		t, err := ParseWithEra(layout, value, era)
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				pe.context["index"] = i
+			}
+			if errs == nil {
+				errs = NewMultiError()
+			}
+			errs.Add(err)
+			continue
+		}
+		times[i] = t
+	}
+
+	if errs == nil {
+		return times, nil
+	}
+	return times, errs
+}
+
+// parseAnyLayouts are the layouts tried by ParseAny, in order. Numeric
+// dates are day first, as written in Thailand. Thai month names are
+// accepted for the English ones by ParseWithEra.
+var parseAnyLayouts = []string{
+	stdtime.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+	"2/1/2006",
+	"2-1-2006",
+	"January 2, 2006",
+	"2 January 2006",
+	"Jan 2, 2006",
+	"2 Jan 2006",
+}
+
+// ParseAny parses value by trying a list of common layouts in order and
+// returning the first success: ISO 8601 ("2006-01-02", with or without a
+// time), day-first numeric dates ("02/01/2006") and dates with English or
+// Thai month names ("January 2, 2006", "2 มกราคม 2006", "2 ม.ค. 2006").
+// Thai digits are accepted.
+//
+// The era is detected from the year with DetectEraFromYear, so
+// "29/02/2567" is a BE time and "29/02/2024" a CE time.
+//
+// If no layout matches, it returns a *MultiError holding the *ParseError of
+// each attempt, in layout order. Use ParseWithEra when the layout is known.
+func ParseAny(value string) (Time, error) {
+	era := CE()
+	converted := normalizeThaiDigits(value)
+	if loc := beYearRegexPool.FindStringSubmatchIndex(converted); loc != nil {
+		if year, err := strconv.Atoi(converted[loc[2]:loc[3]]); err == nil {
+			era = DetectEraFromYear(year)
+		}
+	}
+
+	errs := NewMultiError()
+	for _, layout := range parseAnyLayouts {
+		t, err := ParseWithEra(layout, value, era)
+		if err == nil {
+			return t, nil
+		}
+		errs.Add(err)
+	}
+	return Time{}, errs
+}
+
+// MustParseWithEra is like ParseWithEra but panics if the value cannot be
+// parsed, with the *ParseError as the panic value. It is intended for
+// package-level variables and tests with known-good literals; use
+// ParseWithEra for input that may be invalid.
+func MustParseWithEra(layout, value string, era *Era) Time {
+	t, err := ParseWithEra(layout, value, era)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// MustParseThai is like ParseThai but panics if the value cannot be parsed,
+// with a *ParseError as the panic value. It is intended for package-level
+// variables and tests with known-good literals; use ParseThai for input
+// that may be invalid.
+func MustParseThai(layout, value string) Time {
+	t, err := ParseThai(layout, value)
+	if err != nil {
+		panic(newParseError(value, layout, nil, 0, err))
+	}
+	return t
+}
+
 // ParseThai parses a time string that may contain Thai month and day names.
-// It automatically detects whether the year is in BE or CE format based on
-// proximity to the current year, and returns a Time with the detected era.
+// If the value carries an explicit "พ.ศ." (BE) or "ค.ศ." (CE) marker, the
+// marker is removed and its era is used. Otherwise it automatically detects
+// whether the year is in BE or CE format based on proximity to the current
+// year, and returns a Time with the detected era. Thai digits (๐-๙) are
+// accepted anywhere ASCII digits are.
+//
+// Thai times are also recognized: the "น." marker after a time, as in
+// "15:30 น.", is ignored in both layout and value, and the period words
+// "ก่อนเที่ยง" (AM) and "หลังเที่ยง" (PM) are parsed by a "PM" element or by
+// either word in the layout.
+//
+// Two-digit years ("06") are read as BE years in the 2500s, as printed on
+// receipts, so "15 ม.ค. 67" with layout "2 Jan 06" is BE 2567 (2024 CE).
+// With an explicit "ค.ศ." marker they follow the standard library instead.
 func ParseThai(layout, value string) (Time, error) {
-	converted := replaceThaiMonthNames(value)
-	converted = replaceThaiDayNames(converted)
+	t, _, err := ParseThaiWithMarker(layout, value)
+	return t, err
+}
 
-	t, err := stdtime.Parse(layout, converted)
+// ParseThaiWithMarker is like ParseThai but also reports whether the era was
+// taken from an explicit "พ.ศ." or "ค.ศ." marker in the value rather than
+// detected from the year. The marker should not appear in the layout:
+//
+//	t, marked, err := ParseThaiWithMarker("2 January 2006", "15 มกราคม พ.ศ. 2500")
+//	// t is 1957-01-15 in BE, marked is true
+func ParseThaiWithMarker(layout, value string) (t Time, marked bool, err error) {
+	layout, converted, markerEra, shortBE := prepareThai(layout, value)
+
+	parsed, err := stdtime.Parse(layout, converted)
+	if err != nil {
+		return Time{}, false, err
+	}
+
+	if shortBE {
+		return Time{Time: parsed, era: BE()}, markerEra != nil, nil
+	}
+	return resolveThaiEra(parsed, markerEra), markerEra != nil, nil
+}
+
+// Default range of plausible CE years used by ParseThaiStrict when no
+// window is set with SetEraDetectionWindow.
+const (
+	strictThaiMinCEYear = 1900
+	strictThaiMaxCEYear = 2100
+)
+
+// ParseThaiStrict is like ParseThai but never guesses the era by proximity,
+// for pipelines that prefer to fail on questionable input. The era is taken
+// from an explicit "พ.ศ." or "ค.ศ." marker; without one, the year must lie
+// in the range of plausible CE years either as a CE year or, converted from
+// BE, as a BE year, but not both. The range is the window set with
+// SetEraDetectionWindow, or 1900-2100 CE if none is set.
+//
+// Two-digit years always need a marker, since "67" may be BE 2567 or CE
+// 1967.
+//
+// If the era cannot be determined, an *EraMismatchError is returned whose
+// ExpectedEra and ActualEra are nil and whose Details name both readings of
+// the year:
+//
+//	ParseThaiStrict("2 January 2006", "15 มกราคม 2567")      // BE 2567 (2024 CE)
+//	ParseThaiStrict("2 January 2006", "15 มกราคม 2300")      // error
+//	ParseThaiStrict("2 January 2006", "15 มกราคม พ.ศ. 2300") // BE 2300 (1757 CE)
+//	ParseThaiStrict("2 Jan 06", "15 ม.ค. 67")                // error
+//	ParseThaiStrict("2 Jan 06", "15 ม.ค. พ.ศ. 67")           // BE 2567 (2024 CE)
+func ParseThaiStrict(layout, value string) (Time, error) {
+	layout, converted, markerEra, shortBE := prepareThai(layout, value)
+
+	parsed, err := stdtime.Parse(layout, converted)
 	if err != nil {
 		return Time{}, err
 	}
 
-	detectedEra := DetectEraFromYear(t.Year())
+	if shortBE {
+		if markerEra == nil {
+			// The year was expanded as BE 25xx; the standard library
+			// would read it as CE 19xx or 20xx
+			beYear := BE().FromCE(parsed.Year())
+			shortYear := beYear % 100
+			ceYear := 2000 + shortYear
+			if shortYear >= 69 {
+				ceYear = 1900 + shortYear
+			}
+			return Time{}, newEraMismatchError(nil, nil, "two-digit year "+
+				string(appendPaddedInt(nil, shortYear, 2))+" could be BE "+strconv.Itoa(beYear)+
+				" ("+strconv.Itoa(parsed.Year())+" CE) or CE "+strconv.Itoa(ceYear)+
+				"; add a พ.ศ. or ค.ศ. marker")
+		}
+		return Time{Time: parsed, era: BE()}, nil
+	}
+	if markerEra == nil {
+		year := parsed.Year()
+		markerEra = unambiguousThaiEra(year)
+		if markerEra == nil {
+			return Time{}, newEraMismatchError(nil, nil, "year "+strconv.Itoa(year)+
+				" could be BE "+strconv.Itoa(year)+" ("+strconv.Itoa(BE().ToCE(year))+
+				" CE) or CE "+strconv.Itoa(year)+"; add a พ.ศ. or ค.ศ. marker")
+		}
+	}
+	return resolveThaiEra(parsed, markerEra), nil
+}
 
-	if detectedEra == BE() {
-		ceYear := BE().ToCE(t.Year())
-		t = stdtime.Date(ceYear, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
-		return Time{Time: t, era: BE()}, nil
+// unambiguousThaiEra returns CE or BE if exactly one reading of year lies in
+// the range of plausible CE years used by ParseThaiStrict, and nil otherwise.
+func unambiguousThaiEra(year int) *Era {
+	minYear, maxYear := strictThaiMinCEYear, strictThaiMaxCEYear
+	detectionMu.RLock()
+	if detectionWindow.set {
+		minYear, maxYear = detectionWindow.ceMin, detectionWindow.ceMax
 	}
+	detectionMu.RUnlock()
 
-	return Time{Time: t, era: CE()}, nil
+	asCE := minYear <= year && year <= maxYear
+	asBE := minYear <= BE().ToCE(year) && BE().ToCE(year) <= maxYear
+	switch {
+	case asCE && !asBE:
+		return CE()
+	case asBE && !asCE:
+		return BE()
+	}
+	return nil
 }
 
 // ParseThaiInLocation parses a time string with Thai month and day names
-// in a specific location. It automatically detects whether the year is in
-// BE or CE format based on proximity to the current year.
+// in a specific location. Like ParseThai, an explicit "พ.ศ." or "ค.ศ."
+// marker selects the era; otherwise it automatically detects whether the
+// year is in BE or CE format based on proximity to the current year.
 func ParseThaiInLocation(layout, value string, loc *stdtime.Location) (Time, error) {
-	converted := replaceThaiMonthNames(value)
-	converted = replaceThaiDayNames(converted)
+	layout, converted, markerEra, shortBE := prepareThai(layout, value)
 
 	t, err := stdtime.ParseInLocation(layout, converted, loc)
 	if err != nil {
 		return Time{}, err
 	}
 
-	detectedEra := DetectEraFromYear(t.Year())
+	if shortBE {
+		return Time{Time: t, era: BE()}, nil
+	}
+	return resolveThaiEra(t, markerEra), nil
+}
+
+// prepareThai converts a Thai layout and value for the standard library:
+// it normalizes the value with NormalizeThaiText, normalizes Thai digits and
+// time markers, removes an era marker, and replaces Thai month and day
+// names with English ones. Two-digit years
+// ("06") are BE years in the 2500s unless the value has a "ค.ศ." marker;
+// they are expanded to four-digit CE years and shortBE is true.
+func prepareThai(layout, value string) (parseLayout, converted string, markerEra *Era, shortBE bool) {
+	converted, markerEra = stripThaiEraMarker(normalizeThaiDigits(NormalizeThaiText(value)))
+	converted = normalizeThaiTimeValue(converted)
+	converted = replaceThaiMonthNames(converted)
+	converted = replaceThaiDayNames(converted)
+	parseLayout = normalizeThaiTimeLayout(layout)
+
+	if markerEra != CE() {
+		parseLayout, converted, shortBE = expandShortYears(parseLayout, converted, BE().ToCE(beShortYearBase))
+	}
+	return parseLayout, converted, markerEra, shortBE
+}
+
+// stripThaiEraMarker removes the first "พ.ศ." or "ค.ศ." marker from value,
+// together with one adjacent space, and returns the era it denotes.
+// If value has no marker, it is returned unchanged with a nil era.
+func stripThaiEraMarker(value string) (string, *Era) {
+	markers := [...]struct {
+		marker string
+		era    *Era
+	}{
+		{thaiBEAbbreviation, BE()},
+		{thaiCEAbbreviation, CE()},
+	}
+
+	for _, m := range markers {
+		idx := strings.Index(value, m.marker)
+		if idx < 0 {
+			continue
+		}
+		before, after := value[:idx], value[idx+len(m.marker):]
+		if strings.HasPrefix(after, " ") {
+			after = after[1:]
+		} else if strings.HasSuffix(before, " ") {
+			before = before[:len(before)-1]
+		}
+		return before + after, m.era
+	}
+
+	return value, nil
+}
+
+// Thai time-of-day markers. The hour marker "น." (นาฬิกา, o'clock) follows
+// 24-hour times such as "15:30 น."; the period words mean AM and PM.
+const (
+	thaiHourMarker = "น."
+	thaiBeforeNoon = "ก่อนเที่ยง"
+	thaiAfterNoon  = "หลังเที่ยง"
+)
 
-	if detectedEra == BE() {
+// normalizeThaiTimeValue removes "น." hour markers from value and replaces
+// the Thai period words with "AM" and "PM".
+func normalizeThaiTimeValue(value string) string {
+	value = stripThaiHourMarker(value)
+	if strings.Contains(value, thaiBeforeNoon) {
+		value = strings.ReplaceAll(value, thaiBeforeNoon, "AM")
+	}
+	if strings.Contains(value, thaiAfterNoon) {
+		value = strings.ReplaceAll(value, thaiAfterNoon, "PM")
+	}
+	return value
+}
+
+// normalizeThaiTimeLayout removes "น." hour markers from layout and replaces
+// either Thai period word with the "PM" layout element, so that the layout
+// matches values normalized by normalizeThaiTimeValue.
+func normalizeThaiTimeLayout(layout string) string {
+	layout = stripThaiHourMarker(layout)
+	if strings.Contains(layout, thaiBeforeNoon) {
+		layout = strings.ReplaceAll(layout, thaiBeforeNoon, "PM")
+	}
+	if strings.Contains(layout, thaiAfterNoon) {
+		layout = strings.ReplaceAll(layout, thaiAfterNoon, "PM")
+	}
+	return layout
+}
+
+// stripThaiHourMarker removes each "น." that directly follows a digit,
+// optionally separated by one space, together with that space.
+// Other occurrences are left unchanged.
+func stripThaiHourMarker(s string) string {
+	if !strings.Contains(s, thaiHourMarker) {
+		return s
+	}
+
+	sb := builderPool.Get(len(s))
+	defer builderPool.Put(sb)
+
+	for {
+		idx := strings.Index(s, thaiHourMarker)
+		if idx < 0 {
+			sb.WriteString(s)
+			break
+		}
+
+		end := idx
+		if end > 0 && s[end-1] == ' ' {
+			end--
+		}
+		if end > 0 && s[end-1] >= '0' && s[end-1] <= '9' {
+			sb.WriteString(s[:end])
+		} else {
+			sb.WriteString(s[:idx+len(thaiHourMarker)])
+		}
+		s = s[idx+len(thaiHourMarker):]
+	}
+	return sb.String()
+}
+
+// resolveThaiEra returns t in the given era, converting its year from BE to
+// CE where needed. If era is nil, the era is detected from the year.
+func resolveThaiEra(t stdtime.Time, era *Era) Time {
+	if era == nil {
+		era = DetectEraFromYear(t.Year())
+	}
+
+	if era == BE() {
 		ceYear := BE().ToCE(t.Year())
-		t = stdtime.Date(ceYear, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
-		return Time{Time: t, era: BE()}, nil
+		t = stdtime.Date(ceYear, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		return Time{Time: t, era: BE()}
 	}
 
-	return Time{Time: t, era: CE()}, nil
+	return Time{Time: t, era: CE()}
+}
+
+// eraYearRegexPools caches regex pools built by convertEraYearToCE,
+// keyed by layout string.
+var eraYearRegexPools = internal.NewLayoutCache(internal.DefaultMaxLayoutCacheSize)
+
+// errLayoutMismatch reports a value whose year elements cannot be located
+// because it does not match the layout.
+var errLayoutMismatch = errors.New("value does not match layout")
+
+// convertEraYearToCE rewrites the year elements ("2006") of value, which is
+// formatted with layout, from era years to four-digit CE years so that the
+// standard library can parse it. Era years may have any number of digits,
+// such as Minguo 113. It returns errLayoutMismatch if layout has a year
+// element and value does not match the layout, rather than leaving an era
+// year to be read as a CE year.
+func convertEraYearToCE(layout, value string, era *Era) (string, error) {
+	converted, ok := convertLayoutYears(layout, value, era.ToCE)
+	if !ok && strings.Contains(layout, "2006") {
+		return value, errLayoutMismatch
+	}
+	return converted, nil
 }
 
-func convertBEYearToCE(value string) string {
-	ceValue := beYearRegexPool.ReplaceAllStringFunc(value, func(match string) string {
-		year, err := strconv.Atoi(match)
+// checkEraYears returns a ValidationError for the first year element
+// ("2006") of value, which is formatted with layout, that is not a valid
+// year of era as reported by Era.IsValidYear, such as BE year 0. It returns
+// nil if value does not match the layout.
+func checkEraYears(layout, value string, era *Era) error {
+	invalid, found := 0, false
+	convertLayoutYears(layout, value, func(year int) int {
+		if !found && !era.IsValidYear(year) {
+			invalid, found = year, true
+		}
+		return year
+	})
+	if found {
+		return newValidationError(ErrCodeInvalidEra, "year", invalid, "year is not valid in the "+era.String()+" era")
+	}
+	return nil
+}
+
+// convertLayoutYears rewrites the year elements ("2006") of value, which is
+// formatted with layout, to the four-digit years returned by convert.
+// Only the positions of the layout's year elements are changed. It reports
+// false, returning value unchanged, if value does not match the layout.
+func convertLayoutYears(layout, value string, convert func(year int) int) (string, bool) {
+	if !strings.Contains(layout, "2006") {
+		return value, false
+	}
+
+	var pool *internal.RegexPool
+	if cached, ok := eraYearRegexPools.Load(layout); ok {
+		pool = cached.(*internal.RegexPool)
+	} else {
+		compiled := internal.NewRegexPool(`^` + layoutPattern(layout, "2006", `(-?\d{1,10})`) + `$`)
+		pool = eraYearRegexPools.LoadOrStore(layout, compiled).(*internal.RegexPool)
+	}
+
+	loc := pool.FindStringSubmatchIndex(value)
+	if loc == nil {
+		return value, false
+	}
+
+	sb := builderPool.Get(len(value) + 8)
+	defer builderPool.Put(sb)
+
+	last := 0
+	for g := 2; g+1 < len(loc); g += 2 {
+		start, end := loc[g], loc[g+1]
+		if start < 0 {
+			continue
+		}
+		year, err := strconv.Atoi(value[start:end])
 		if err != nil {
-			return match
+			return value, false
 		}
-		if DetectEraFromYear(year) == BE() {
-			ceYear := BE().ToCE(year)
-			return fmt.Sprintf("%d", ceYear)
+		sb.WriteString(value[last:start])
+		sb.Write(appendPaddedInt(nil, convert(year), 4))
+		last = end
+	}
+	sb.WriteString(value[last:])
+	return sb.String(), true
+}
+
+// layoutYear returns the first year element ("2006") of value, which is
+// formatted with layout. It reports false if layout has no year element or
+// value does not match it.
+func layoutYear(layout, value string) (int, bool) {
+	year, found := 0, false
+	convertLayoutYears(layout, value, func(y int) int {
+		if !found {
+			year, found = y, true
 		}
-		return match
+		return y
 	})
-	return ceValue
+	return year, found
+}
+
+// shortYearRegexPools caches regex pools built by expandShortYears,
+// keyed by layout string.
+var shortYearRegexPools = internal.NewLayoutCache(internal.DefaultMaxLayoutCacheSize)
+
+// expandShortYears rewrites the two-digit year elements ("06") of value,
+// which is formatted with layout, to the four-digit years base+yy, and the
+// elements in layout to "2006", so that the standard library does not apply
+// its own century rule. It reports false, returning layout and value
+// unchanged, if layout has no "06" element or value does not match layout.
+func expandShortYears(layout, value string, base int) (string, string, bool) {
+	if !strings.Contains(layout, "06") {
+		return layout, value, false
+	}
+
+	var pool *internal.RegexPool
+	if cached, ok := shortYearRegexPools.Load(layout); ok {
+		pool = cached.(*internal.RegexPool)
+	} else {
+		compiled := internal.NewRegexPool(`^` + layoutPattern(layout, "06", `(\d{2})`) + `$`)
+		pool = shortYearRegexPools.LoadOrStore(layout, compiled).(*internal.RegexPool)
+	}
+
+	loc := pool.FindStringSubmatchIndex(value)
+	if len(loc) <= 2 {
+		// No match, or no "06" element outside longer elements like "2006"
+		return layout, value, false
+	}
+
+	sb := builderPool.Get(len(value) + 4)
+	defer builderPool.Put(sb)
+
+	last := 0
+	for g := 2; g+1 < len(loc); g += 2 {
+		start, end := loc[g], loc[g+1]
+		yy, err := strconv.Atoi(value[start:end])
+		if err != nil {
+			return layout, value, false
+		}
+		sb.WriteString(value[last:start])
+		sb.Write(appendPaddedInt(nil, base+yy, 4))
+		last = end
+	}
+	sb.WriteString(value[last:])
+
+	return replaceLayoutToken(layout, "06", "2006"), sb.String(), true
+}
+
+// replaceLayoutToken replaces each from element of layout with to,
+// recognizing elements with nextLayoutElement.
+func replaceLayoutToken(layout, from, to string) string {
+	var sb strings.Builder
+
+	for layout != "" {
+		prefix, elem, suffix := nextLayoutElement(layout)
+		sb.WriteString(prefix)
+		if elem == from {
+			sb.WriteString(to)
+		} else {
+			sb.WriteString(elem)
+		}
+		layout = suffix
+	}
+
+	return sb.String()
+}
+
+// beShortYearBase is the BE century that two-digit BE years belong to, so
+// that "67" means BE 2567.
+const beShortYearBase = 2500
+
+// eraShortYearBase returns the century of era years that two-digit years
+// ("06") of era are read in, which is the century of the current year in
+// era, so that "13" is Minguo 113 in 2024. It is never negative.
+func eraShortYearBase(era *Era) int {
+	year := era.FromCE(stdtime.Now().Year())
+	if year < 0 {
+		return 0
+	}
+	return year / 100 * 100
+}
+
+// convertBEYearToCE converts the BE year in value, which is formatted with
+// layout, to CE. Years are located by the position of the layout's "2006"
+// element, so other 4-digit numbers in the value, such as fractional
+// seconds, are left alone, and a year is only converted if it is detected
+// as BE. It returns errLayoutMismatch if layout has a year element and value
+// does not match the layout.
+func convertBEYearToCE(layout, value string) (string, error) {
+	value = normalizeThaiDigits(value)
+
+	converted, ok := convertLayoutYears(layout, value, beYearToCE)
+	if !ok && strings.Contains(layout, "2006") {
+		return value, errLayoutMismatch
+	}
+	return converted, nil
+}
+
+// beYearToCE converts year to CE if it is detected as a BE year and returns
+// it unchanged otherwise.
+func beYearToCE(year int) int {
+	if DetectEraFromYear(year) == BE() {
+		return BE().ToCE(year)
+	}
+	return year
+}
+
+// layoutRegexPools caches regex pools built from layouts by ExtractDates,
+// keyed by layout string. Each layout is compiled only once, up to the
+// cache's size limit.
+var layoutRegexPools = internal.NewLayoutCache(internal.DefaultMaxLayoutCacheSize)
+
+// Regex fragments for the month and day name elements. The standard
+// library matches names without regard to case.
+const (
+	longMonthPattern  = `(?i:January|February|March|April|May|June|July|August|September|October|November|December)`
+	shortMonthPattern = `(?i:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)`
+	longDayPattern    = `(?i:Monday|Tuesday|Wednesday|Thursday|Friday|Saturday|Sunday)`
+	shortDayPattern   = `(?i:Mon|Tue|Wed|Thu|Fri|Sat|Sun)`
+)
+
+// optionalFractionPattern matches fractional seconds that time.Parse accepts
+// after a seconds element, or for a ".999" element, even when absent.
+const optionalFractionPattern = `(?:[.,]\d+)?`
+
+// layoutElementPattern returns the regex fragment that matches the text
+// time.Parse accepts for elem, a layout element as split by
+// nextLayoutElement.
+func layoutElementPattern(elem string) string {
+	switch elem {
+	case "January":
+		return longMonthPattern
+	case "Jan":
+		return shortMonthPattern
+	case "Monday":
+		return longDayPattern
+	case "Mon":
+		return shortDayPattern
+	case "MST":
+		return `(?:[A-Z][A-Za-z]{2,4}|GMT[+-]\d{1,2}|[+-]\d{1,2})`
+	case "2006":
+		return `\d{4,10}`
+	case "01", "02", "03", "04", "05", "06":
+		return `\d{2}`
+	case "1", "2", "3", "4", "5", "15":
+		return `\d{1,2}`
+	case "_2":
+		return ` ?\d{1,2}`
+	case "__2":
+		return ` {0,2}\d{1,3}`
+	case "002":
+		return `\d{3}`
+	case "PM":
+		return `(?:AM|PM)`
+	case "pm":
+		return `(?:am|pm)`
+	}
+
+	switch elem[0] {
+	case '.', ',': // fractional seconds
+		if elem[1] == '9' {
+			return optionalFractionPattern
+		}
+		return `[.,]\d{` + strconv.Itoa(len(elem)-1) + `}`
+	case '-', 'Z': // zone offsets
+		offset := `[+-]` + strings.NewReplacer("0", `\d`, "7", `\d`).Replace(elem[1:])
+		if elem[0] == 'Z' {
+			return `(?:Z|` + offset + `)`
+		}
+		return offset
+	}
+	return regexp.QuoteMeta(elem)
+}
+
+// layoutToRegexPattern converts a Go time layout into a regex pattern that
+// matches strings formatted with that layout. Literal text in the layout is
+// quoted, and the pattern is anchored on word boundaries so that digits
+// embedded in longer numbers are not matched.
+func layoutToRegexPattern(layout string) string {
+	return `\b` + layoutPattern(layout, "", "") + `\b`
+}
+
+// layoutPattern converts a Go time layout into an unanchored regex pattern.
+// Layout elements are recognized by nextLayoutElement, as by time.Parse.
+// If capturePattern is non-empty, it is used for each captureToken element
+// (such as "2006") instead of the element's default pattern.
+func layoutPattern(layout, captureToken, capturePattern string) string {
+	var sb strings.Builder
+
+	for layout != "" {
+		prefix, elem, suffix := nextLayoutElement(layout)
+		sb.WriteString(regexp.QuoteMeta(prefix))
+		layout = suffix
+
+		switch {
+		case elem == "":
+			continue
+		case elem == captureToken && capturePattern != "":
+			sb.WriteString(capturePattern)
+		default:
+			sb.WriteString(layoutElementPattern(elem))
+		}
+
+		// Like time.Parse, accept fractional seconds after the seconds
+		// unless the layout has its own fraction element next
+		if elem == "05" || elem == "5" {
+			if _, next, _ := nextLayoutElement(suffix); next == "" || (next[0] != '.' && next[0] != ',') {
+				sb.WriteString(optionalFractionPattern)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// layoutRegexPool returns the cached regex pool for the given layout,
+// building it on first use.
+func layoutRegexPool(layout string) *internal.RegexPool {
+	if pool, ok := layoutRegexPools.Load(layout); ok {
+		return pool.(*internal.RegexPool)
+	}
+	return layoutRegexPools.LoadOrStore(layout, internal.NewRegexPool(layoutToRegexPattern(layout))).(*internal.RegexPool)
+}
+
+// ExtractDates finds all substrings of text that match the given layout and
+// parses each of them with ParseWithEra. Thai month and day names in the text
+// are recognized. Candidates that look like the layout but fail to parse
+// (for example "31/02/2567") are skipped, as are numbers that do not fit
+// the layout.
+//
+// This is useful for bulk extraction of dates from documents, such as Thai
+// official letters where several BE dates appear in free text.
+func ExtractDates(text, layout string, era *Era) []Time {
+	normalized := replaceThaiMonthNames(text)
+	normalized = replaceThaiDayNames(normalized)
+
+	matches := layoutRegexPool(layout).FindAllString(normalized, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	result := make([]Time, 0, len(matches))
+	for _, match := range matches {
+		t, err := ParseWithEra(layout, match, era)
+		if err != nil {
+			continue
+		}
+		result = append(result, t)
+	}
+	return result
 }
 
 // ParseWithLocale parses a time string using locale-aware era detection.
@@ -407,7 +1802,7 @@ func ParseWithLocale(layout, value, locale string) (Time, error) {
 	if detectedEra == nil {
 		t, err := stdtime.Parse(layout, value)
 		if err != nil {
-			return Time{}, newParseError(value, layout, nil, 0, err)
+			return Time{}, newParseError(value, layout, nil, parseErrorPosition(value, value, err), err)
 		}
 
 		detectedEra = DetectEraFromYear(t.Year())
@@ -1,6 +1,10 @@
 package time
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"sort"
 	"strings"
 	"testing"
 	stdtime "time"
@@ -280,6 +284,123 @@ func TestDefaultEraIsCE(t *testing.T) {
 	}
 }
 
+// TestFromStdRoundTrip tests wrapping and unwrapping a standard time
+func TestFromStdRoundTrip(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	tests := []stdtime.Time{
+		stdtime.Date(2024, 2, 29, 12, 30, 45, 123456789, stdtime.UTC),
+		stdtime.Date(2024, 6, 15, 8, 0, 0, 0, bangkok),
+		{},
+	}
+
+	for _, x := range tests {
+		wrapped := FromStd(x)
+		if !wrapped.IsCE() {
+			t.Errorf("FromStd(%v) era = %v, want CE", x, wrapped.Era())
+		}
+		if !wrapped.StdTime().Equal(x) {
+			t.Errorf("FromStd(%v).StdTime() = %v, want equal", x, wrapped.StdTime())
+		}
+		if wrapped.StdTime().Location() != x.Location() {
+			t.Errorf("FromStd(%v).StdTime().Location() = %v, want %v", x, wrapped.StdTime().Location(), x.Location())
+		}
+	}
+
+	// StdTime is unaffected by the era
+	beTime := FromStd(tests[0]).InEra(BE())
+	if beTime.StdTime().Year() != 2024 {
+		t.Errorf("StdTime().Year() for BE time = %d, want 2024", beTime.StdTime().Year())
+	}
+}
+
+// TestNowIn tests that NowIn returns the current instant in the location
+func TestNowIn(t *testing.T) {
+	bangkok, err := stdtime.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		t.Skipf("Asia/Bangkok not available: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		loc      *stdtime.Location
+		expected *stdtime.Location
+	}{
+		{"Bangkok", bangkok, bangkok},
+		{"UTC", stdtime.UTC, stdtime.UTC},
+		{"Nil is local", nil, stdtime.Local},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := stdtime.Now()
+			got := NowIn(tt.loc)
+			after := stdtime.Now()
+
+			if got.Location() != tt.expected {
+				t.Errorf("NowIn(%v).Location() = %v, want %v", tt.loc, got.Location(), tt.expected)
+			}
+			if got.Time.Before(before) || got.Time.After(after) {
+				t.Errorf("NowIn(%v) = %v, want between %v and %v", tt.loc, got.Time, before, after)
+			}
+			if got.Era() != CE() {
+				t.Errorf("NowIn(%v).Era() = %v, want CE", tt.loc, got.Era())
+			}
+		})
+	}
+}
+
+// TestNewTime tests building a Time from options
+func TestNewTime(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+
+	tests := []struct {
+		name        string
+		opts        []TimeOption
+		expected    stdtime.Time
+		expectedEra *Era
+	}{
+		{"Date only", []TimeOption{WithDate(2024, 2, 29)}, stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Date and clock", []TimeOption{WithDate(2024, 2, 29), WithClock(12, 30, 45, 100)}, stdtime.Date(2024, 2, 29, 12, 30, 45, 100, stdtime.UTC), CE()},
+		{"Date, location and era", []TimeOption{WithDate(2024, 2, 29), WithLocation(bangkok), WithEra(BE())}, stdtime.Date(2024, 2, 29, 0, 0, 0, 0, bangkok), BE()},
+		{"Nil location ignored", []TimeOption{WithDate(2024, 2, 29), WithLocation(nil)}, stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), CE()},
+		{"Later options win", []TimeOption{WithEra(BE()), WithDate(2020, 1, 1), WithEra(ROC()), WithDate(2024, 2, 29)}, stdtime.Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), ROC()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTime(tt.opts...)
+			if !got.Time.Equal(tt.expected) || got.Location() != tt.expected.Location() {
+				t.Errorf("NewTime() = %v, want %v", got.Time, tt.expected)
+			}
+			if got.Era() != tt.expectedEra {
+				t.Errorf("NewTime().Era() = %v, want %v", got.Era(), tt.expectedEra)
+			}
+		})
+	}
+
+	// Without a date, the current date is used
+	before := stdtime.Now()
+	now := NewTime()
+	after := stdtime.Now()
+	if now.Time.Before(before) || now.Time.After(after) || now.Location() != stdtime.UTC || now.Era() != CE() {
+		t.Errorf("NewTime() = %v in %v, want the current time in UTC and CE", now.Time, now.Era())
+	}
+
+	// With a clock but no date, today's date in the location is used
+	before = stdtime.Now().In(bangkok)
+	clockOnly := NewTime(WithClock(9, 15, 0, 0), WithLocation(bangkok))
+	after = stdtime.Now().In(bangkok)
+	sameDay := func(a, b stdtime.Time) bool {
+		y1, m1, d1 := a.Date()
+		y2, m2, d2 := b.Date()
+		return y1 == y2 && m1 == m2 && d1 == d2
+	}
+	if clockOnly.Hour() != 9 || clockOnly.Minute() != 15 ||
+		!(sameDay(clockOnly.Time, before) || sameDay(clockOnly.Time, after)) {
+		t.Errorf("NewTime(WithClock) = %v, want 09:15 today", clockOnly.Time)
+	}
+}
+
 // TestEraFlagMethods tests IsCE() and IsBE() helper methods
 func TestEraFlagMethods(t *testing.T) {
 	ceTime := Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)
@@ -360,6 +481,46 @@ func TestTimeOperationsPreserveEra(t *testing.T) {
 	}
 }
 
+// TestAddDatePreservesEra tests that AddDate keeps the era and normalizes dates
+func TestAddDatePreservesEra(t *testing.T) {
+	tests := []struct {
+		name                string
+		tm                  Time
+		years, months, days int
+		expectedYear        int
+		expectedMonth       stdtime.Month
+		expectedDay         int
+		expectBE            bool
+	}{
+		{"BE add one year", Date(2024, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 1, 0, 0, 2568, stdtime.June, 15, true},
+		{"BE Jan 31 plus one month in leap year", Date(2024, 1, 31, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 0, 1, 0, 2567, stdtime.March, 2, true},
+		{"BE Jan 31 plus one month in non-leap year", Date(2023, 1, 31, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 0, 1, 0, 2566, stdtime.March, 3, true},
+		{"BE leap day plus one year", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 1, 0, 0, 2568, stdtime.March, 1, true},
+		{"BE leap day plus four years", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 4, 0, 0, 2571, stdtime.February, 29, true},
+		{"BE Feb 28 plus one day in leap year", Date(2024, 2, 28, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), 0, 0, 1, 2567, stdtime.February, 29, true},
+		{"CE Dec 31 plus one day", Date(2024, 12, 31, 0, 0, 0, 0, stdtime.UTC), 0, 0, 1, 2025, stdtime.January, 1, false},
+		{"CE leap day minus one year", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), -1, 0, 0, 2023, stdtime.March, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.tm.AddDate(tt.years, tt.months, tt.days)
+			if result.IsBE() != tt.expectBE {
+				t.Errorf("AddDate() IsBE = %v, want %v", result.IsBE(), tt.expectBE)
+			}
+			if result.Year() != tt.expectedYear {
+				t.Errorf("AddDate() Year = %d, want %d", result.Year(), tt.expectedYear)
+			}
+			if result.Month() != tt.expectedMonth {
+				t.Errorf("AddDate() Month = %v, want %v", result.Month(), tt.expectedMonth)
+			}
+			if result.Day() != tt.expectedDay {
+				t.Errorf("AddDate() Day = %d, want %d", result.Day(), tt.expectedDay)
+			}
+		})
+	}
+}
+
 // TestTimeComparisons tests comparison operations work correctly
 func TestTimeComparisons(t *testing.T) {
 	t1 := Date(2024, 2, 29, 12, 0, 0, 0, stdtime.UTC)
@@ -423,6 +584,52 @@ func TestTimeLocations(t *testing.T) {
 	}
 }
 
+// TestLocationChangePreservesEra tests that UTC, Local, and In keep the era
+func TestLocationChangePreservesEra(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	beTime := Date(2024, 6, 15, 20, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+	inBangkok := beTime.In(bangkok)
+	if !inBangkok.IsBE() {
+		t.Error("In() should preserve era")
+	}
+	if inBangkok.Year() != 2567 {
+		t.Errorf("In(bangkok).Year() = %d, want 2567", inBangkok.Year())
+	}
+	if inBangkok.Location() != bangkok {
+		t.Errorf("In(bangkok).Location() = %v, want %v", inBangkok.Location(), bangkok)
+	}
+	// 20:00 UTC is 03:00 the next day in Bangkok
+	if inBangkok.Day() != 16 || inBangkok.Hour() != 3 {
+		t.Errorf("In(bangkok) wall clock = day %d hour %d, want day 16 hour 3", inBangkok.Day(), inBangkok.Hour())
+	}
+	if !inBangkok.Equal(beTime) {
+		t.Error("In() should represent the same instant")
+	}
+
+	backToUTC := inBangkok.UTC()
+	if !backToUTC.IsBE() {
+		t.Error("UTC() should preserve era")
+	}
+	if backToUTC.Location() != stdtime.UTC || backToUTC.Hour() != 20 {
+		t.Errorf("UTC() = %v, want 20:00 UTC", backToUTC.Time)
+	}
+
+	local := beTime.Local()
+	if !local.IsBE() {
+		t.Error("Local() should preserve era")
+	}
+	if local.Location() != stdtime.Local {
+		t.Errorf("Local().Location() = %v, want Local", local.Location())
+	}
+
+	// A year boundary crossed by the location change is reflected in the era year
+	newYear := Date(2024, 12, 31, 20, 0, 0, 0, stdtime.UTC).InEra(BE()).In(bangkok)
+	if newYear.Year() != 2568 {
+		t.Errorf("In(bangkok).Year() across new year = %d, want 2568", newYear.Year())
+	}
+}
+
 // TestTimeUnixTimestamp tests Unix timestamp consistency with leap days
 func TestTimeUnixTimestamp(t *testing.T) {
 	// 2024-02-29 12:00:00 UTC
@@ -553,6 +760,52 @@ func TestZeroTime(t *testing.T) {
 	}
 }
 
+// TestFormatRFC3339Era tests that only the year field uses the era year
+func TestFormatRFC3339Era(t *testing.T) {
+	ict := stdtime.FixedZone("ICT", 7*60*60)
+	tests := []struct {
+		name     string
+		tm       Time
+		expected string
+	}{
+		{"BE", Date(2024, 2, 29, 12, 0, 0, 0, stdtime.UTC).InEra(BE()), "2567-02-29T12:00:00Z"},
+		{"Clock digits look like a year", Date(2024, 2, 29, 20, 24, 25, 202400000, stdtime.UTC).InEra(BE()), "2567-02-29T20:24:25.2024Z"},
+		{"Fraction equal to CE year", Date(2024, 2, 29, 12, 0, 0, 2024, ict).InEra(BE()), "2567-02-29T12:00:00.000002024+07:00"},
+		{"CE", Date(2024, 2, 29, 12, 0, 0, 0, stdtime.UTC), "2024-02-29T12:00:00Z"},
+		{"ROC", Date(2024, 2, 29, 12, 0, 0, 0, stdtime.UTC).InEra(ROC()), "0113-02-29T12:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatRFC3339Era(); got != tt.expected {
+				t.Errorf("FormatRFC3339Era() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatOrEmpty tests that the zero time renders as an empty string
+func TestFormatOrEmpty(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		expected string
+	}{
+		{"Zero time", Time{}, ""},
+		{"Zero time in BE", Time{}.InEra(BE()), ""},
+		{"Real CE time", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), "2024-02-29"},
+		{"Real BE time", Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), "2567-02-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.FormatOrEmpty("2006-01-02"); got != tt.expected {
+				t.Errorf("FormatOrEmpty() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
 // TestJSONMarshaling tests JSON marshaling with leap days
 func TestJSONMarshaling(t *testing.T) {
 	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
@@ -582,6 +835,174 @@ func TestJSONMarshaling(t *testing.T) {
 	}
 }
 
+// TestJSONEmitEraYear tests JSON round trips of a BE time with and without
+// era years
+func TestJSONEmitEraYear(t *testing.T) {
+	tm := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		name     string
+		emit     bool
+		expected string
+		wantEra  *Era
+	}{
+		{"Default CE year", false, `"2024-02-29T12:30:45Z"`, CE()},
+		{"Era year", true, `"2567-02-29T12:30:45Z"`, BE()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetJSONEmitEraYear(tt.emit)
+			defer SetJSONEmitEraYear(false)
+
+			data, err := json.Marshal(tm)
+			if err != nil {
+				t.Fatalf("json.Marshal() error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.expected)
+			}
+
+			var unmarshaled Time
+			if err := json.Unmarshal(data, &unmarshaled); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error: %v", data, err)
+			}
+			if !unmarshaled.Time.Equal(tm.Time) {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", data, unmarshaled.Time, tm.Time)
+			}
+			if unmarshaled.Era() != tt.wantEra {
+				t.Errorf("json.Unmarshal(%s).Era() = %v, want %v", data, unmarshaled.Era(), tt.wantEra)
+			}
+		})
+	}
+
+	SetJSONEmitEraYear(true)
+	defer SetJSONEmitEraYear(false)
+
+	// A CE time is unaffected, and the receiver's era takes precedence over
+	// year detection
+	if data, _ := json.Marshal(Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)); string(data) != `"2024-02-29T00:00:00Z"` {
+		t.Errorf("json.Marshal(CE) = %s, want %s", data, `"2024-02-29T00:00:00Z"`)
+	}
+	roc := Time{}.InEra(ROC())
+	if err := json.Unmarshal([]byte(`"0113-02-29T00:00:00+08:00"`), &roc); err != nil {
+		t.Fatalf("json.Unmarshal(ROC) error: %v", err)
+	}
+	if roc.YearCE() != 2024 || roc.Era() != ROC() {
+		t.Errorf("json.Unmarshal(ROC) = %d in %v, want 2024 in ROC", roc.YearCE(), roc.Era())
+	}
+	if err := json.Unmarshal([]byte(`"29/02/2567"`), &roc); !IsParseError(err) {
+		t.Errorf("json.Unmarshal(invalid) error = %v, want ParseError", err)
+	}
+}
+
+// TestJSONLayout tests JSON round trips with a date-only layout
+func TestJSONLayout(t *testing.T) {
+	SetJSONLayout("2006-01-02")
+	defer SetJSONLayout("")
+
+	type record struct {
+		Due Time `json:"due"`
+	}
+
+	tests := []struct {
+		name     string
+		emit     bool
+		tm       Time
+		expected string
+		wantEra  *Era
+	}{
+		{"CE leap day", false, Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC), `{"due":"2024-02-29"}`, CE()},
+		{"BE leap day in CE", false, Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), `{"due":"2024-02-29"}`, CE()},
+		{"BE leap day in era year", true, Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC).InEra(BE()), `{"due":"2567-02-29"}`, BE()},
+		{"Century leap day", false, Date(2000, 2, 29, 0, 0, 0, 0, stdtime.UTC), `{"due":"2000-02-29"}`, CE()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetJSONEmitEraYear(tt.emit)
+			defer SetJSONEmitEraYear(false)
+
+			data, err := json.Marshal(record{Due: tt.tm})
+			if err != nil {
+				t.Fatalf("json.Marshal() error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.expected)
+			}
+
+			var got record
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error: %v", data, err)
+			}
+			if !got.Due.Time.Equal(tt.tm.Time) {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", data, got.Due.Time, tt.tm.Time)
+			}
+			if got.Due.Era() != tt.wantEra {
+				t.Errorf("json.Unmarshal(%s).Era() = %v, want %v", data, got.Due.Era(), tt.wantEra)
+			}
+		})
+	}
+
+	// Like the default encoding, a CE value keeps the receiver's era
+	be := Time{}.InEra(BE())
+	if err := json.Unmarshal([]byte(`"2024-02-29"`), &be); err != nil {
+		t.Fatalf("json.Unmarshal(BE receiver) error: %v", err)
+	}
+	if be.YearCE() != 2024 || be.Era() != BE() {
+		t.Errorf("json.Unmarshal(BE receiver) = %d in %v, want 2024 in BE", be.YearCE(), be.Era())
+	}
+
+	var got record
+	if err := json.Unmarshal([]byte(`{"due":"2023-02-29"}`), &got); !IsParseError(err) {
+		t.Errorf("json.Unmarshal(invalid leap day) error = %v, want ParseError", err)
+	}
+	if err := json.Unmarshal([]byte(`{"due":"2024-02-29T00:00:00Z"}`), &got); !IsParseError(err) {
+		t.Errorf("json.Unmarshal(RFC 3339) error = %v, want ParseError", err)
+	}
+}
+
+// TestTextMarshaling tests text marshaling round trips with leap days
+func TestTextMarshaling(t *testing.T) {
+	tests := []struct {
+		name     string
+		tm       Time
+		expected string
+	}{
+		{"CE leap day", Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC), "2024-02-29T12:30:45Z"},
+		{"BE leap day keeps CE instant", Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE()), "2024-02-29T12:30:45Z"},
+		{"Nanoseconds and offset", Date(2024, 2, 29, 23, 59, 59, 123456789, stdtime.FixedZone("ICT", 7*60*60)), "2024-02-29T23:59:59.123456789+07:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.tm.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("MarshalText() = %q, want %q", data, tt.expected)
+			}
+
+			var unmarshaled Time
+			if err := unmarshaled.UnmarshalText(data); err != nil {
+				t.Fatalf("UnmarshalText() error: %v", err)
+			}
+			if !unmarshaled.Time.Equal(tt.tm.Time) {
+				t.Errorf("UnmarshalText() = %v, want %v", unmarshaled.Time, tt.tm.Time)
+			}
+			if unmarshaled.Era() != CE() {
+				t.Errorf("UnmarshalText().Era() = %v, want CE", unmarshaled.Era())
+			}
+		})
+	}
+
+	var invalid Time
+	if err := invalid.UnmarshalText([]byte("29/02/2567")); err == nil {
+		t.Error("UnmarshalText(invalid) expected error")
+	}
+}
+
 // TestGobEncoding tests Gob encoding with leap days
 func TestGobEncoding(t *testing.T) {
 	tm := Date(2024, 2, 29, 12, 30, 45, 123456789, stdtime.UTC)
@@ -611,6 +1032,75 @@ func TestGobEncoding(t *testing.T) {
 	}
 }
 
+// TestGobEncodingEra tests that Gob encoding preserves the era
+func TestGobEncodingEra(t *testing.T) {
+	bangkok := stdtime.FixedZone("ICT", 7*60*60)
+	custom := RegisterEra("GobEra", 100)
+	defer UnregisterEra("GobEra")
+
+	tests := []struct {
+		name string
+		tm   Time
+	}{
+		{"BE", Date(2024, 2, 29, 12, 30, 45, 123456789, bangkok).InEra(BE())},
+		{"ROC", Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(ROC())},
+		{"Registered era", Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(custom)},
+		{"CE", Date(2024, 2, 29, 12, 30, 45, 0, bangkok)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tt.tm); err != nil {
+				t.Fatalf("Encode() error: %v", err)
+			}
+			var decoded Time
+			if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+				t.Fatalf("Decode() error: %v", err)
+			}
+			if !decoded.Time.Equal(tt.tm.Time) || decoded.Era() != tt.tm.Era() {
+				t.Errorf("Gob round trip = %v in %v, want %v in %v", decoded.Time, decoded.Era(), tt.tm.Time, tt.tm.Era())
+			}
+		})
+	}
+
+	beTime := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC).InEra(BE())
+	data, err := beTime.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode() error: %v", err)
+	}
+	var decoded Time
+	if err := decoded.GobDecode(data); err != nil || !decoded.IsBE() {
+		t.Errorf("GobDecode(GobEncode(BE)) = %v in %v, %v; want BE", decoded.Time, decoded.Era(), err)
+	}
+
+	// CE times are encoded exactly like time.Time, in both directions
+	ceTime := Date(2024, 2, 29, 12, 30, 45, 0, stdtime.UTC)
+	data, err = ceTime.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode() error: %v", err)
+	}
+	stdData, _ := ceTime.Time.GobEncode()
+	if !bytes.Equal(data, stdData) {
+		t.Errorf("GobEncode(CE) = %x, want %x", data, stdData)
+	}
+	var std stdtime.Time
+	if err := std.GobDecode(data); err != nil || !std.Equal(ceTime.Time) {
+		t.Errorf("time.Time.GobDecode(GobEncode(CE)) = %v, %v; want %v", std, err, ceTime.Time)
+	}
+
+	// Unknown era names decode as CE
+	unknown := append(stdData, "NoSuchEra"...)
+	decoded = beTime
+	if err := decoded.GobDecode(unknown); err != nil || !decoded.IsCE() || !decoded.Time.Equal(ceTime.Time) {
+		t.Errorf("GobDecode(unknown era) = %v in %v, %v; want %v in CE", decoded.Time, decoded.Era(), err, ceTime.Time)
+	}
+
+	if err := decoded.GobDecode(nil); err == nil {
+		t.Error("GobDecode(nil) expected error")
+	}
+}
+
 // TestSubDuration tests the Sub method for duration calculations
 func TestSubDuration(t *testing.T) {
 	tests := []struct {
@@ -727,3 +1217,303 @@ func TestParseInLocationMatchesStdlib(t *testing.T) {
 		})
 	}
 }
+
+// TestConvertEra tests converting a time between eras
+func TestConvertEra(t *testing.T) {
+	custom := RegisterEraWithOptions(EraOptions{Name: "ConvertEraCustom", Offset: -2000})
+	defer UnregisterEra("ConvertEraCustom")
+
+	be := Date(2024, 2, 29, 12, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		name     string
+		target   *Era
+		wantEra  *Era
+		wantYear int
+	}{
+		{"BE to ROC", ROC(), ROC(), 113},
+		{"BE to custom", custom, custom, 24},
+		{"BE to CE", CE(), CE(), 2024},
+		{"BE to nil", nil, CE(), 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := be.ConvertEra(tt.target)
+			if got.Era() != tt.wantEra {
+				t.Errorf("ConvertEra().Era() = %v, want %v", got.Era(), tt.wantEra)
+			}
+			if got.Year() != tt.wantYear {
+				t.Errorf("ConvertEra().Year() = %d, want %d", got.Year(), tt.wantYear)
+			}
+			if !got.Time.Equal(be.Time) {
+				t.Errorf("ConvertEra() instant = %v, want %v", got.Time, be.Time)
+			}
+		})
+	}
+}
+
+// TestEraYear tests reading the year in another era without converting
+func TestEraYear(t *testing.T) {
+	years := []int{1912, 1957, 2000, 2024, 2100}
+	eras := []*Era{CE(), BE(), ROC()}
+
+	for _, year := range years {
+		tm := Date(year, 6, 15, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+		for _, era := range eras {
+			if got, want := tm.EraYear(era), tm.InEra(era).Year(); got != want {
+				t.Errorf("EraYear(%v) for %d = %d, want %d", era, year, got, want)
+			}
+		}
+		if got := tm.EraYear(nil); got != year {
+			t.Errorf("EraYear(nil) for %d = %d, want %d", year, got, year)
+		}
+		if tm.Era() != BE() {
+			t.Errorf("EraYear() changed era to %v", tm.Era())
+		}
+	}
+
+	tm := Date(2024, 1, 1, 0, 0, 0, 0, stdtime.UTC)
+	if ce, be, roc := tm.EraYear(CE()), tm.EraYear(BE()), tm.EraYear(ROC()); ce != 2024 || be != 2567 || roc != 113 {
+		t.Errorf("EraYear(CE, BE, ROC) = %d, %d, %d; want 2024, 2567, 113", ce, be, roc)
+	}
+}
+
+// TestSameEra tests era comparison between two times
+func TestSameEra(t *testing.T) {
+	base := Date(2024, 2, 29, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		a        Time
+		b        Time
+		expected bool
+	}{
+		{"Two BE times", base.InEra(BE()), base.Add(24 * stdtime.Hour).InEra(BE()), true},
+		{"BE and CE", base.InEra(BE()), base.InEra(CE()), false},
+		{"No era and CE", base, base.InEra(CE()), true},
+		{"Distinct eras with same name and offset", base.InEra(&Era{name: "BE", offset: BEOffset}), base.InEra(BE()), true},
+		{"Same name different offset", base.InEra(&Era{name: "BE", offset: 100}), base.InEra(BE()), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.SameEra(tt.b); got != tt.expected {
+				t.Errorf("SameEra() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCompare tests instant comparison and sorting of mixed-era times
+func TestCompare(t *testing.T) {
+	base := Date(2024, 2, 29, 12, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name     string
+		a        Time
+		b        Time
+		expected int
+	}{
+		{"Before", base, base.Add(stdtime.Second), -1},
+		{"After", base.Add(stdtime.Second), base, +1},
+		{"Equal", base, base, 0},
+		{"Same instant different eras", base.InEra(BE()), base, 0},
+		{"Same instant different locations", base, FromStd(base.Time.In(stdtime.FixedZone("ICT", 7*60*60))), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.expected {
+				t.Errorf("Compare() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+
+	times := []Time{
+		base.AddDate(1, 0, 0),
+		base.InEra(BE()),
+		base.AddDate(-1, 0, 0).InEra(BE()),
+		base.AddDate(0, 0, 1),
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Compare(times[j]) < 0
+	})
+
+	expected := []Time{
+		base.AddDate(-1, 0, 0),
+		base,
+		base.AddDate(0, 0, 1),
+		base.AddDate(1, 0, 0),
+	}
+	for i := range times {
+		if !times[i].Equal(expected[i]) {
+			t.Errorf("sorted[%d] = %v, want %v", i, times[i].Time, expected[i].Time)
+		}
+	}
+}
+
+// TestBetweenAndInRange tests interval checks with mixed-era endpoints
+func TestBetweenAndInRange(t *testing.T) {
+	start := Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	end := Date(2024, 5, 1, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name        string
+		tm          Time
+		start       Time
+		end         Time
+		wantBetween bool
+		wantInRange bool
+	}{
+		{"Inside", Date(2024, 4, 15, 0, 0, 0, 0, stdtime.UTC), start, end, true, true},
+		{"At start", Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC), start, end, true, true},
+		{"At end", Date(2024, 5, 1, 0, 0, 0, 0, stdtime.UTC), start, end, false, true},
+		{"Before start", start.Add(-stdtime.Nanosecond).InEra(CE()), start, end, false, false},
+		{"After end", end.Add(stdtime.Nanosecond), start, end, false, false},
+		{"Start in another location", Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC), FromStd(start.Time.In(stdtime.FixedZone("ICT", 7*60*60))), end.InEra(BE()), true, true},
+		{"Empty interval", Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC), start, start, false, true},
+		{"Start after end", Date(2024, 4, 15, 0, 0, 0, 0, stdtime.UTC), end, start, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tm.Between(tt.start, tt.end); got != tt.wantBetween {
+				t.Errorf("Between() = %v, want %v", got, tt.wantBetween)
+			}
+			if got := tt.tm.InRange(tt.start, tt.end); got != tt.wantInRange {
+				t.Errorf("InRange() = %v, want %v", got, tt.wantInRange)
+			}
+		})
+	}
+}
+
+// TestClamp tests limiting a time to a window with mixed-era bounds
+func TestClamp(t *testing.T) {
+	lo := Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC)
+	hi := Date(2024, 5, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+
+	tests := []struct {
+		name     string
+		tm       Time
+		expected stdtime.Time
+	}{
+		{"Inside", Date(2024, 4, 15, 0, 0, 0, 0, stdtime.UTC).InEra(ROC()), stdtime.Date(2024, 4, 15, 0, 0, 0, 0, stdtime.UTC)},
+		{"At min", lo.InEra(ROC()), lo.Time},
+		{"At max", hi.InEra(ROC()), hi.Time},
+		{"Before min", Date(2023, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(ROC()), lo.Time},
+		{"After max", Date(2025, 1, 1, 0, 0, 0, 0, stdtime.UTC).InEra(ROC()), hi.Time},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.tm.Clamp(lo, hi)
+			if !got.Time.Equal(tt.expected) {
+				t.Errorf("Clamp() = %v, want %v", got.Time, tt.expected)
+			}
+			if got.Era() != ROC() {
+				t.Errorf("Clamp().Era() = %v, want ROC", got.Era())
+			}
+		})
+	}
+}
+
+// TestMinMax tests choosing the earlier and later of two mixed-era times
+func TestMinMax(t *testing.T) {
+	early := Date(2024, 4, 1, 0, 0, 0, 0, stdtime.UTC).InEra(BE())
+	late := Date(2024, 5, 1, 0, 0, 0, 0, stdtime.UTC)
+
+	tests := []struct {
+		name    string
+		a       Time
+		b       Time
+		wantMin Time
+		wantMax Time
+	}{
+		{"Ordered", early, late, early, late},
+		{"Reversed", late, early, early, late},
+		{"Tie returns first", early, early.InEra(CE()), early, early},
+		{"Tie returns first reversed", early.InEra(CE()), early, early.InEra(CE()), early.InEra(CE())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Min(tt.a, tt.b); !got.Equal(tt.wantMin) || got.Era() != tt.wantMin.Era() {
+				t.Errorf("Min() = %v (%v), want %v (%v)", got.Time, got.Era(), tt.wantMin.Time, tt.wantMin.Era())
+			}
+			if got := Max(tt.a, tt.b); !got.Equal(tt.wantMax) || got.Era() != tt.wantMax.Era() {
+				t.Errorf("Max() = %v (%v), want %v (%v)", got.Time, got.Era(), tt.wantMax.Time, tt.wantMax.Era())
+			}
+		})
+	}
+}
+
+// TestSinceUntil tests elapsed durations against an injected reference date
+func TestSinceUntil(t *testing.T) {
+	ref := stdtime.Date(2024, 4, 15, 12, 0, 0, 0, stdtime.UTC)
+	SetElapsedReferenceDate(ref)
+	defer SetElapsedReferenceDate(stdtime.Time{})
+
+	tests := []struct {
+		name      string
+		tm        Time
+		wantSince stdtime.Duration
+	}{
+		{"Past", Date(2024, 4, 15, 9, 30, 0, 0, stdtime.UTC), 150 * stdtime.Minute},
+		{"Past in BE", Date(2024, 4, 14, 12, 0, 0, 0, stdtime.UTC).InEra(BE()), 24 * stdtime.Hour},
+		{"Future", Date(2024, 4, 15, 12, 0, 45, 0, stdtime.UTC), -45 * stdtime.Second},
+		{"Future in another location", FromStd(stdtime.Date(2024, 4, 15, 20, 0, 0, 0, stdtime.FixedZone("ICT", 7*60*60))).InEra(BE()), -stdtime.Hour},
+		{"Reference instant", FromStd(ref), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Since(tt.tm); got != tt.wantSince {
+				t.Errorf("Since() = %v, want %v", got, tt.wantSince)
+			}
+			if got := Until(tt.tm); got != -tt.wantSince {
+				t.Errorf("Until() = %v, want %v", got, -tt.wantSince)
+			}
+		})
+	}
+}
+
+// TestUnixConversions tests Unix accessors and constructors against the stdlib
+func TestUnixConversions(t *testing.T) {
+	timestamps := []stdtime.Time{
+		stdtime.Date(2024, 2, 29, 12, 30, 45, 123456789, stdtime.UTC),
+		stdtime.Date(1970, 1, 1, 0, 0, 0, 0, stdtime.UTC),
+		stdtime.Date(1957, 6, 15, 8, 0, 0, 999999, stdtime.UTC),
+	}
+
+	for _, std := range timestamps {
+		t.Run(std.Format(stdtime.RFC3339Nano), func(t *testing.T) {
+			tm := FromStd(std).InEra(BE())
+
+			if got := tm.UnixMilli(); got != std.UnixMilli() {
+				t.Errorf("UnixMilli() = %d, want %d", got, std.UnixMilli())
+			}
+			if got := tm.UnixMicro(); got != std.UnixMicro() {
+				t.Errorf("UnixMicro() = %d, want %d", got, std.UnixMicro())
+			}
+
+			constructors := []struct {
+				name     string
+				got      Time
+				expected stdtime.Time
+			}{
+				{"Unix", Unix(std.Unix(), int64(std.Nanosecond())), stdtime.Unix(std.Unix(), int64(std.Nanosecond()))},
+				{"UnixMilli", UnixMilli(std.UnixMilli()), stdtime.UnixMilli(std.UnixMilli())},
+				{"UnixMicro", UnixMicro(std.UnixMicro()), stdtime.UnixMicro(std.UnixMicro())},
+			}
+			for _, c := range constructors {
+				if !c.got.Time.Equal(c.expected) {
+					t.Errorf("%s() = %v, want %v", c.name, c.got.Time, c.expected)
+				}
+				if c.got.Era() != CE() {
+					t.Errorf("%s().Era() = %v, want CE", c.name, c.got.Era())
+				}
+			}
+		})
+	}
+}